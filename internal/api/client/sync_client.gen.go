@@ -24,6 +24,7 @@ const (
 // Defines values for ManifestFlagType.
 const (
 	ManifestFlagTypeBoolean ManifestFlagType = "boolean"
+	ManifestFlagTypeFloat   ManifestFlagType = "float"
 	ManifestFlagTypeInteger ManifestFlagType = "integer"
 	ManifestFlagTypeObject  ManifestFlagType = "object"
 	ManifestFlagTypeString  ManifestFlagType = "string"
@@ -34,6 +35,8 @@ func (e ManifestFlagType) Valid() bool {
 	switch e {
 	case ManifestFlagTypeBoolean:
 		return true
+	case ManifestFlagTypeFloat:
+		return true
 	case ManifestFlagTypeInteger:
 		return true
 	case ManifestFlagTypeObject:
@@ -48,6 +51,7 @@ func (e ManifestFlagType) Valid() bool {
 // Defines values for PostOpenfeatureV0ManifestFlagsJSONBodyType.
 const (
 	PostOpenfeatureV0ManifestFlagsJSONBodyTypeBoolean PostOpenfeatureV0ManifestFlagsJSONBodyType = "boolean"
+	PostOpenfeatureV0ManifestFlagsJSONBodyTypeFloat   PostOpenfeatureV0ManifestFlagsJSONBodyType = "float"
 	PostOpenfeatureV0ManifestFlagsJSONBodyTypeInteger PostOpenfeatureV0ManifestFlagsJSONBodyType = "integer"
 	PostOpenfeatureV0ManifestFlagsJSONBodyTypeObject  PostOpenfeatureV0ManifestFlagsJSONBodyType = "object"
 	PostOpenfeatureV0ManifestFlagsJSONBodyTypeString  PostOpenfeatureV0ManifestFlagsJSONBodyType = "string"
@@ -58,6 +62,8 @@ func (e PostOpenfeatureV0ManifestFlagsJSONBodyType) Valid() bool {
 	switch e {
 	case PostOpenfeatureV0ManifestFlagsJSONBodyTypeBoolean:
 		return true
+	case PostOpenfeatureV0ManifestFlagsJSONBodyTypeFloat:
+		return true
 	case PostOpenfeatureV0ManifestFlagsJSONBodyTypeInteger:
 		return true
 	case PostOpenfeatureV0ManifestFlagsJSONBodyTypeObject:
@@ -72,6 +78,7 @@ func (e PostOpenfeatureV0ManifestFlagsJSONBodyType) Valid() bool {
 // Defines values for PutOpenfeatureV0ManifestFlagsKeyJSONBodyType.
 const (
 	Boolean PutOpenfeatureV0ManifestFlagsKeyJSONBodyType = "boolean"
+	Float   PutOpenfeatureV0ManifestFlagsKeyJSONBodyType = "float"
 	Integer PutOpenfeatureV0ManifestFlagsKeyJSONBodyType = "integer"
 	Object  PutOpenfeatureV0ManifestFlagsKeyJSONBodyType = "object"
 	String  PutOpenfeatureV0ManifestFlagsKeyJSONBodyType = "string"
@@ -82,6 +89,8 @@ func (e PutOpenfeatureV0ManifestFlagsKeyJSONBodyType) Valid() bool {
 	switch e {
 	case Boolean:
 		return true
+	case Float:
+		return true
 	case Integer:
 		return true
 	case Object:
@@ -121,7 +130,7 @@ type ErrorResponse struct {
 	} `json:"error"`
 }
 
-// FlagDefaultValue Default value for a flag (can be boolean, string, integer, or object)
+// FlagDefaultValue Default value for a flag (can be boolean, string, integer, float, or object)
 type FlagDefaultValue struct {
 	union json.RawMessage
 }
@@ -138,6 +147,9 @@ type FlagDefaultValue2 = int
 // FlagDefaultValue3 defines model for .
 type FlagDefaultValue3 = map[string]interface{}
 
+// FlagDefaultValue4 defines model for .
+type FlagDefaultValue4 = float64
+
 // ManifestEnvelope defines model for ManifestEnvelope.
 type ManifestEnvelope struct {
 	Flags []ManifestFlag `json:"flags"`
@@ -145,7 +157,7 @@ type ManifestEnvelope struct {
 
 // ManifestFlag defines model for ManifestFlag.
 type ManifestFlag struct {
-	// DefaultValue Default value for a flag (can be boolean, string, integer, or object)
+	// DefaultValue Default value for a flag (can be boolean, string, integer, float, or object)
 	DefaultValue FlagDefaultValue `json:"defaultValue"`
 
 	// Description Optional flag description.
@@ -178,7 +190,7 @@ type bearerAuthContextKey string
 
 // PostOpenfeatureV0ManifestFlagsJSONBody defines parameters for PostOpenfeatureV0ManifestFlags.
 type PostOpenfeatureV0ManifestFlagsJSONBody struct {
-	// DefaultValue Default value for a flag (can be boolean, string, integer, or object)
+	// DefaultValue Default value for a flag (can be boolean, string, integer, float, or object)
 	DefaultValue FlagDefaultValue `json:"defaultValue"`
 	Description  *string          `json:"description,omitempty"`
 	Key          string           `json:"key"`
@@ -193,7 +205,7 @@ type PostOpenfeatureV0ManifestFlagsJSONBodyType string
 
 // PutOpenfeatureV0ManifestFlagsKeyJSONBody defines parameters for PutOpenfeatureV0ManifestFlagsKey.
 type PutOpenfeatureV0ManifestFlagsKeyJSONBody struct {
-	// DefaultValue Default value for a flag (can be boolean, string, integer, or object)
+	// DefaultValue Default value for a flag (can be boolean, string, integer, float, or object)
 	DefaultValue *FlagDefaultValue                            `json:"defaultValue,omitempty"`
 	Description  *string                                      `json:"description,omitempty"`
 	Key          string                                       `json:"key"`
@@ -314,6 +326,32 @@ func (t *FlagDefaultValue) MergeFlagDefaultValue3(v FlagDefaultValue3) error {
 	return err
 }
 
+// AsFlagDefaultValue4 returns the union data inside the FlagDefaultValue as a FlagDefaultValue4
+func (t FlagDefaultValue) AsFlagDefaultValue4() (FlagDefaultValue4, error) {
+	var body FlagDefaultValue4
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromFlagDefaultValue4 overwrites any union data inside the FlagDefaultValue as the provided FlagDefaultValue4
+func (t *FlagDefaultValue) FromFlagDefaultValue4(v FlagDefaultValue4) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeFlagDefaultValue4 performs a merge with any union data inside the FlagDefaultValue, using the provided FlagDefaultValue4
+func (t *FlagDefaultValue) MergeFlagDefaultValue4(v FlagDefaultValue4) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t FlagDefaultValue) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
@@ -0,0 +1,445 @@
+// Package conformance checks that a provider implements the Manifest Management API as specified in api/v0/sync.yaml
+package conformance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	syncclient "github.com/open-feature/cli/internal/api/client"
+	"github.com/open-feature/cli/internal/logger"
+)
+
+// capabilitiesHeader lists the capabilities of the token used for a request
+const capabilitiesHeader = "X-Manifest-Capabilities"
+
+// Status is the outcome of a conformance check
+type Status string
+
+const (
+	StatusPass Status = "pass"
+	StatusFail Status = "fail"
+	StatusSkip Status = "skip"
+)
+
+// Result is the outcome of checking a single requirement of the specification
+type Result struct {
+	ID          string `json:"id"`
+	Requirement string `json:"requirement"`
+	Status      Status `json:"status"`
+	Message     string `json:"message,omitempty"`
+}
+
+// Report holds the results of a conformance run in the order the requirements were checked
+type Report struct {
+	ProviderURL string   `json:"providerUrl"`
+	Results     []Result `json:"results"`
+}
+
+// Count returns the number of results with the given status
+func (r *Report) Count(status Status) int {
+	count := 0
+	for _, result := range r.Results {
+		if result.Status == status {
+			count++
+		}
+	}
+	return count
+}
+
+// Options configures a conformance run
+type Options struct {
+	// KeyPrefix is prepended to the keys of the flags created during the run
+	KeyPrefix string
+}
+
+// runner executes the conformance scenario against a provider
+type runner struct {
+	client *syncclient.ClientWithResponses
+	report *Report
+	keys   map[string]string
+	// created holds the keys that still need to be cleaned up
+	created []string
+}
+
+// Run executes the conformance scenario against the provider and reports a result per requirement.
+// The scenario creates, updates, conflicts, deletes and re-fetches flags whose keys start with the key prefix,
+// and deletes any flags it created before returning.
+func Run(ctx context.Context, client *syncclient.ClientWithResponses, providerURL string, opts Options) *Report {
+	prefix := opts.KeyPrefix
+	if prefix == "" {
+		prefix = fmt.Sprintf("openfeature-conformance-%d", time.Now().Unix())
+	}
+
+	r := &runner{
+		client: client,
+		report: &Report{ProviderURL: providerURL},
+		keys: map[string]string{
+			"boolean": prefix + "-boolean",
+			"float":   prefix + "-float",
+			"missing": prefix + "-missing",
+		},
+	}
+	defer r.cleanup(ctx)
+
+	if !r.checkManifest(ctx) {
+		r.skipRemaining("the manifest could not be fetched")
+		return r.report
+	}
+	if !r.checkCreate(ctx) {
+		r.skipRemaining("the test flag could not be created")
+		return r.report
+	}
+	r.checkCreateConflict(ctx)
+	r.checkCreateValidation(ctx)
+	r.checkFloat(ctx)
+	r.checkUpdate(ctx)
+	r.checkUpdateMismatchedKey(ctx)
+	r.checkUpdateTypeChange(ctx)
+	r.checkUpdateMissing(ctx)
+	if r.checkDelete(ctx) {
+		r.checkRefetchAfterDelete(ctx)
+		r.checkCreateArchived(ctx)
+	} else {
+		r.skip("manifest.excludes-deleted", "the test flag could not be deleted")
+		r.skip("create.archived-conflict", "the test flag could not be deleted")
+	}
+	r.checkDeleteMissing(ctx)
+
+	return r.report
+}
+
+// requirements lists every requirement checked, used to mark the remaining ones as skipped
+var requirements = []struct{ id, description string }{
+	{"manifest.get", "GET /manifest returns 200 with a flags array"},
+	{"manifest.capabilities", "Responses include the X-Manifest-Capabilities header"},
+	{"create.success", "POST /manifest/flags returns 201 with the flag and updatedAt"},
+	{"manifest.includes-created", "Created flags are included in the manifest"},
+	{"create.conflict", "Creating an existing key returns 409"},
+	{"create.validation", "Creating a flag without a key returns 400"},
+	{"create.float", "Float flags are accepted"},
+	{"update.success", "PUT /manifest/flags/{key} returns 200 with the updated flag and updatedAt"},
+	{"update.mismatched-key", "Updating with a different key in the body returns 400"},
+	{"update.type-change", "Changing the flag type returns 409"},
+	{"update.not-found", "Updating an unknown key returns 404"},
+	{"delete.success", "DELETE /manifest/flags/{key} returns 200 with a message"},
+	{"manifest.excludes-deleted", "Deleted flags are excluded from the manifest"},
+	{"create.archived-conflict", "Creating a deleted key returns 409 when the provider archives flags"},
+	{"delete.not-found", "Deleting an unknown key returns 404"},
+}
+
+func (r *runner) checkManifest(ctx context.Context) bool {
+	resp, err := r.client.GetOpenfeatureV0ManifestWithResponse(ctx)
+	if err != nil {
+		r.fail("manifest.get", err.Error())
+		return false
+	}
+	if resp.StatusCode() != http.StatusOK || resp.JSON200 == nil {
+		r.fail("manifest.get", unexpectedStatus(http.StatusOK, resp.StatusCode(), resp.Body))
+		return false
+	}
+	r.pass("manifest.get")
+
+	if header := resp.HTTPResponse.Header.Get(capabilitiesHeader); header != "" {
+		r.pass("manifest.capabilities")
+	} else {
+		r.fail("manifest.capabilities", "the header is missing from the GET /manifest response")
+	}
+	return true
+}
+
+func (r *runner) checkCreate(ctx context.Context) bool {
+	key := r.keys["boolean"]
+	resp, err := r.client.PostOpenfeatureV0ManifestFlagsWithResponse(ctx, syncclient.PostOpenfeatureV0ManifestFlagsJSONRequestBody{
+		Key:          key,
+		Type:         "boolean",
+		DefaultValue: defaultValue(false),
+		Description:  ptr("Created by the OpenFeature CLI conformance suite"),
+	})
+	if err != nil {
+		r.fail("create.success", err.Error())
+		return false
+	}
+	if resp.StatusCode() != http.StatusCreated {
+		r.fail("create.success", unexpectedStatus(http.StatusCreated, resp.StatusCode(), resp.Body))
+		return false
+	}
+	r.created = append(r.created, key)
+
+	switch {
+	case resp.JSON201 == nil:
+		r.fail("create.success", "the response body is not a ManifestFlagResponse")
+	case resp.JSON201.Flag.Key != key:
+		r.fail("create.success", fmt.Sprintf("the response flag key is %q, expected %q", resp.JSON201.Flag.Key, key))
+	case resp.JSON201.UpdatedAt.IsZero():
+		r.fail("create.success", "the response is missing updatedAt")
+	default:
+		r.pass("create.success")
+	}
+
+	if flag, found, err := r.findFlag(ctx, key); err != nil {
+		r.fail("manifest.includes-created", err.Error())
+	} else if !found {
+		r.fail("manifest.includes-created", fmt.Sprintf("flag %q is missing from the manifest", key))
+	} else if value, _ := flag.DefaultValue.AsFlagDefaultValue0(); value {
+		r.fail("manifest.includes-created", "the flag does not have the default value it was created with")
+	} else {
+		r.pass("manifest.includes-created")
+	}
+	return true
+}
+
+func (r *runner) checkCreateConflict(ctx context.Context) {
+	resp, err := r.client.PostOpenfeatureV0ManifestFlagsWithResponse(ctx, syncclient.PostOpenfeatureV0ManifestFlagsJSONRequestBody{
+		Key:          r.keys["boolean"],
+		Type:         "boolean",
+		DefaultValue: defaultValue(true),
+	})
+	r.expectStatus("create.conflict", http.StatusConflict, resp, err)
+}
+
+func (r *runner) checkCreateValidation(ctx context.Context) {
+	resp, err := r.client.PostOpenfeatureV0ManifestFlagsWithBodyWithResponse(ctx, "application/json",
+		strings.NewReader(`{"type":"boolean","defaultValue":true}`))
+	r.expectStatus("create.validation", http.StatusBadRequest, resp, err)
+}
+
+func (r *runner) checkFloat(ctx context.Context) {
+	key := r.keys["float"]
+	resp, err := r.client.PostOpenfeatureV0ManifestFlagsWithResponse(ctx, syncclient.PostOpenfeatureV0ManifestFlagsJSONRequestBody{
+		Key:          key,
+		Type:         "float",
+		DefaultValue: defaultValue(0.5),
+	})
+	if err == nil && resp.StatusCode() == http.StatusCreated {
+		r.created = append(r.created, key)
+	}
+	r.expectStatus("create.float", http.StatusCreated, resp, err)
+}
+
+func (r *runner) checkUpdate(ctx context.Context) {
+	key := r.keys["boolean"]
+	value := defaultValue(true)
+	resp, err := r.client.PutOpenfeatureV0ManifestFlagsKeyWithResponse(ctx, key, syncclient.PutOpenfeatureV0ManifestFlagsKeyJSONRequestBody{
+		Key:          key,
+		Type:         "boolean",
+		DefaultValue: &value,
+		Description:  ptr("Updated by the OpenFeature CLI conformance suite"),
+	})
+	if err != nil {
+		r.fail("update.success", err.Error())
+		return
+	}
+	if resp.StatusCode() != http.StatusOK {
+		r.fail("update.success", unexpectedStatus(http.StatusOK, resp.StatusCode(), resp.Body))
+		return
+	}
+
+	switch {
+	case resp.JSON200 == nil:
+		r.fail("update.success", "the response body is not a ManifestFlagResponse")
+	case resp.JSON200.UpdatedAt.IsZero():
+		r.fail("update.success", "the response is missing updatedAt")
+	default:
+		if updated, _ := resp.JSON200.Flag.DefaultValue.AsFlagDefaultValue0(); !updated {
+			r.fail("update.success", "the response does not contain the updated default value")
+			return
+		}
+		r.pass("update.success")
+	}
+}
+
+func (r *runner) checkUpdateMismatchedKey(ctx context.Context) {
+	value := defaultValue(true)
+	resp, err := r.client.PutOpenfeatureV0ManifestFlagsKeyWithResponse(ctx, r.keys["boolean"], syncclient.PutOpenfeatureV0ManifestFlagsKeyJSONRequestBody{
+		Key:          r.keys["missing"],
+		Type:         "boolean",
+		DefaultValue: &value,
+	})
+	r.expectStatus("update.mismatched-key", http.StatusBadRequest, resp, err)
+}
+
+func (r *runner) checkUpdateTypeChange(ctx context.Context) {
+	value := defaultValue("on")
+	resp, err := r.client.PutOpenfeatureV0ManifestFlagsKeyWithResponse(ctx, r.keys["boolean"], syncclient.PutOpenfeatureV0ManifestFlagsKeyJSONRequestBody{
+		Key:          r.keys["boolean"],
+		Type:         "string",
+		DefaultValue: &value,
+	})
+	r.expectStatus("update.type-change", http.StatusConflict, resp, err)
+}
+
+func (r *runner) checkUpdateMissing(ctx context.Context) {
+	value := defaultValue(true)
+	resp, err := r.client.PutOpenfeatureV0ManifestFlagsKeyWithResponse(ctx, r.keys["missing"], syncclient.PutOpenfeatureV0ManifestFlagsKeyJSONRequestBody{
+		Key:          r.keys["missing"],
+		Type:         "boolean",
+		DefaultValue: &value,
+	})
+	r.expectStatus("update.not-found", http.StatusNotFound, resp, err)
+}
+
+func (r *runner) checkDelete(ctx context.Context) bool {
+	key := r.keys["boolean"]
+	resp, err := r.client.DeleteOpenfeatureV0ManifestFlagsKeyWithResponse(ctx, key)
+	if err != nil {
+		r.fail("delete.success", err.Error())
+		return false
+	}
+	if resp.StatusCode() != http.StatusOK {
+		r.fail("delete.success", unexpectedStatus(http.StatusOK, resp.StatusCode(), resp.Body))
+		return false
+	}
+	r.created = slices.DeleteFunc(r.created, func(created string) bool { return created == key })
+
+	if resp.JSON200 == nil || resp.JSON200.Message == "" {
+		r.fail("delete.success", "the response body is not an ArchiveResponse with a message")
+	} else {
+		r.pass("delete.success")
+	}
+	return true
+}
+
+func (r *runner) checkRefetchAfterDelete(ctx context.Context) {
+	key := r.keys["boolean"]
+	if _, found, err := r.findFlag(ctx, key); err != nil {
+		r.fail("manifest.excludes-deleted", err.Error())
+	} else if found {
+		r.fail("manifest.excludes-deleted", fmt.Sprintf("flag %q is still in the manifest", key))
+	} else {
+		r.pass("manifest.excludes-deleted")
+	}
+}
+
+func (r *runner) checkCreateArchived(ctx context.Context) {
+	key := r.keys["boolean"]
+	resp, err := r.client.PostOpenfeatureV0ManifestFlagsWithResponse(ctx, syncclient.PostOpenfeatureV0ManifestFlagsJSONRequestBody{
+		Key:          key,
+		Type:         "boolean",
+		DefaultValue: defaultValue(false),
+	})
+	if err == nil && resp.StatusCode() == http.StatusCreated {
+		// The provider hard-deletes flags, so the key can be reused
+		r.created = append(r.created, key)
+		r.skip("create.archived-conflict", "the provider hard-deletes flags")
+		return
+	}
+	r.expectStatus("create.archived-conflict", http.StatusConflict, resp, err)
+}
+
+func (r *runner) checkDeleteMissing(ctx context.Context) {
+	resp, err := r.client.DeleteOpenfeatureV0ManifestFlagsKeyWithResponse(ctx, r.keys["missing"])
+	r.expectStatus("delete.not-found", http.StatusNotFound, resp, err)
+}
+
+// cleanup deletes the flags created during the run
+func (r *runner) cleanup(ctx context.Context) {
+	for _, key := range r.created {
+		if _, err := r.client.DeleteOpenfeatureV0ManifestFlagsKeyWithResponse(ctx, key); err != nil {
+			logger.Default.Debug(fmt.Sprintf("failed to clean up conformance flag %s: %v", key, err))
+		}
+	}
+}
+
+// findFlag fetches the manifest and returns the flag with the given key
+func (r *runner) findFlag(ctx context.Context, key string) (syncclient.ManifestFlag, bool, error) {
+	resp, err := r.client.GetOpenfeatureV0ManifestWithResponse(ctx)
+	if err != nil {
+		return syncclient.ManifestFlag{}, false, err
+	}
+	if resp.JSON200 == nil {
+		return syncclient.ManifestFlag{}, false, fmt.Errorf("re-fetching the manifest failed: %s", unexpectedStatus(http.StatusOK, resp.StatusCode(), resp.Body))
+	}
+	for _, flag := range resp.JSON200.Flags {
+		if flag.Key == key {
+			return flag, true, nil
+		}
+	}
+	return syncclient.ManifestFlag{}, false, nil
+}
+
+// statusResponse is implemented by the generated response types
+type statusResponse interface {
+	StatusCode() int
+}
+
+func (r *runner) expectStatus(id string, expected int, resp statusResponse, err error) {
+	if err != nil {
+		r.fail(id, err.Error())
+		return
+	}
+	if resp.StatusCode() != expected {
+		r.fail(id, unexpectedStatus(expected, resp.StatusCode(), responseBody(resp)))
+		return
+	}
+	r.pass(id)
+}
+
+func (r *runner) pass(id string) {
+	r.add(id, StatusPass, "")
+}
+
+func (r *runner) fail(id, message string) {
+	r.add(id, StatusFail, message)
+}
+
+func (r *runner) skip(id, reason string) {
+	r.add(id, StatusSkip, reason)
+}
+
+// skipRemaining marks every requirement without a result as skipped
+func (r *runner) skipRemaining(reason string) {
+	for _, requirement := range requirements {
+		checked := slices.ContainsFunc(r.report.Results, func(result Result) bool { return result.ID == requirement.id })
+		if !checked {
+			r.skip(requirement.id, reason)
+		}
+	}
+}
+
+func (r *runner) add(id string, status Status, message string) {
+	description := id
+	for _, requirement := range requirements {
+		if requirement.id == id {
+			description = requirement.description
+		}
+	}
+	r.report.Results = append(r.report.Results, Result{ID: id, Requirement: description, Status: status, Message: message})
+}
+
+func unexpectedStatus(expected, actual int, body []byte) string {
+	message := fmt.Sprintf("expected status %d, got %d", expected, actual)
+	var errorResp syncclient.ErrorResponse
+	if err := json.Unmarshal(body, &errorResp); err == nil && errorResp.Error.Message != "" {
+		message += ": " + errorResp.Error.Message
+	}
+	return message
+}
+
+func responseBody(resp statusResponse) []byte {
+	switch r := resp.(type) {
+	case *syncclient.PostOpenfeatureV0ManifestFlagsResponse:
+		return r.Body
+	case *syncclient.PutOpenfeatureV0ManifestFlagsKeyResponse:
+		return r.Body
+	case *syncclient.DeleteOpenfeatureV0ManifestFlagsKeyResponse:
+		return r.Body
+	default:
+		return nil
+	}
+}
+
+func defaultValue(value any) syncclient.FlagDefaultValue {
+	var result syncclient.FlagDefaultValue
+	data, _ := json.Marshal(value)
+	_ = result.UnmarshalJSON(data)
+	return result
+}
+
+func ptr[T any](value T) *T {
+	return &value
+}
@@ -0,0 +1,158 @@
+package conformance
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/open-feature/cli/internal/api/mock"
+	"github.com/open-feature/cli/internal/api/sync"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testManifest = `{
+	"$schema": "https://raw.githubusercontent.com/open-feature/cli/refs/heads/main/schema/v0/flag-manifest.json",
+	"flags": {
+		"search-rollout": {
+			"flagType": "boolean",
+			"defaultValue": false
+		}
+	}
+}`
+
+func newMockProvider(t *testing.T, handler func(http.Handler) http.Handler) string {
+	t.Helper()
+
+	fs := afero.NewMemMapFs()
+	filesystem.SetFileSystem(fs)
+	require.NoError(t, afero.WriteFile(fs, "flags.json", []byte(testManifest), 0o644))
+
+	server, err := mock.NewServer("flags.json", mock.Options{
+		AuthToken:    "secret",
+		Capabilities: []mock.Capability{mock.CapabilityRead, mock.CapabilityWrite, mock.CapabilityDelete},
+	})
+	require.NoError(t, err)
+
+	var h http.Handler = server
+	if handler != nil {
+		h = handler(server)
+	}
+	httpServer := httptest.NewServer(h)
+	t.Cleanup(httpServer.Close)
+	return httpServer.URL
+}
+
+func runConformance(t *testing.T, providerURL string) *Report {
+	t.Helper()
+
+	client, err := sync.NewAPIClient(providerURL, "secret")
+	require.NoError(t, err)
+	return Run(context.Background(), client, providerURL, Options{KeyPrefix: "conformance"})
+}
+
+func statuses(report *Report) map[string]Status {
+	result := make(map[string]Status)
+	for _, r := range report.Results {
+		result[r.ID] = r.Status
+	}
+	return result
+}
+
+func TestRunAgainstMockServer(t *testing.T) {
+	providerURL := newMockProvider(t, nil)
+
+	report := runConformance(t, providerURL)
+
+	for _, result := range report.Results {
+		assert.Equal(t, StatusPass, result.Status, "%s: %s", result.ID, result.Message)
+	}
+	assert.Len(t, report.Results, len(requirements))
+
+	// Flags created during the run are cleaned up
+	fs, err := manifest.LoadFlagSet("flags.json", manifest.LoadOptions{})
+	require.NoError(t, err)
+	require.Len(t, fs.Flags, 1)
+	assert.Equal(t, "search-rollout", fs.Flags[0].Key)
+}
+
+func TestRunReportsFailures(t *testing.T) {
+	tests := []struct {
+		name     string
+		handler  func(http.Handler) http.Handler
+		expected map[string]Status
+	}{
+		{
+			name: "unreachable manifest skips the remaining checks",
+			handler: func(next http.Handler) http.Handler {
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusInternalServerError)
+				})
+			},
+			expected: map[string]Status{
+				"manifest.get":     StatusFail,
+				"create.success":   StatusSkip,
+				"delete.not-found": StatusSkip,
+			},
+		},
+		{
+			name: "float flags rejected",
+			handler: func(next http.Handler) http.Handler {
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					body, _ := io.ReadAll(r.Body)
+					if r.Method == http.MethodPost && bytes.Contains(body, []byte(`"type":"float"`)) {
+						w.WriteHeader(http.StatusBadRequest)
+						return
+					}
+					r.Body = io.NopCloser(bytes.NewReader(body))
+					next.ServeHTTP(w, r)
+				})
+			},
+			expected: map[string]Status{
+				"create.success": StatusPass,
+				"create.float":   StatusFail,
+				"delete.success": StatusPass,
+			},
+		},
+		{
+			name: "hard-deleting provider skips the archived check",
+			handler: func(next http.Handler) http.Handler {
+				archived := false
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					if r.Method == http.MethodDelete {
+						archived = true
+					}
+					if archived && r.Method == http.MethodPost {
+						w.WriteHeader(http.StatusCreated)
+						return
+					}
+					next.ServeHTTP(w, r)
+				})
+			},
+			expected: map[string]Status{
+				"delete.success":           StatusPass,
+				"create.archived-conflict": StatusSkip,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			providerURL := newMockProvider(t, tt.handler)
+
+			report := runConformance(t, providerURL)
+
+			actual := statuses(report)
+			for id, status := range tt.expected {
+				assert.Equal(t, status, actual[id], id)
+			}
+			assert.Len(t, report.Results, len(requirements))
+		})
+	}
+}
@@ -0,0 +1,458 @@
+// Package mock provides a local implementation of the Manifest Management API backed by a manifest file
+package mock
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	syncclient "github.com/open-feature/cli/internal/api/client"
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/open-feature/cli/internal/logger"
+	"github.com/open-feature/cli/internal/manifest"
+)
+
+const (
+	manifestPath = "/openfeature/v0/manifest"
+	flagsPath    = manifestPath + "/flags"
+
+	// CapabilitiesHeader lists the capabilities of the token used for a request
+	CapabilitiesHeader = "X-Manifest-Capabilities"
+)
+
+// Capability is a permission granted to the API token
+type Capability string
+
+const (
+	CapabilityRead   Capability = "read"
+	CapabilityWrite  Capability = "write"
+	CapabilityDelete Capability = "delete"
+)
+
+// GetValidCapabilities returns the capabilities as a list of strings
+func GetValidCapabilities() []string {
+	return []string{string(CapabilityRead), string(CapabilityWrite), string(CapabilityDelete)}
+}
+
+// Options configures the mock server
+type Options struct {
+	// AuthToken is the bearer token clients must send. Requests are not authenticated when empty.
+	AuthToken string
+	// Capabilities are granted to authenticated requests
+	Capabilities []Capability
+	// Now returns the current time and defaults to time.Now
+	Now func() time.Time
+}
+
+type flagRecord struct {
+	flag      flagset.Flag
+	updatedAt time.Time
+}
+
+// Server serves the flags of a manifest file through the Manifest Management API.
+// Changes are written back to the manifest; archived flags are only kept in memory.
+type Server struct {
+	manifestPath string
+	opts         Options
+
+	mu       sync.Mutex
+	flags    map[string]*flagRecord
+	archived map[string]time.Time
+}
+
+// NewServer creates a server for the manifest at the given path
+func NewServer(path string, opts Options) (*Server, error) {
+	if opts.Now == nil {
+		opts.Now = time.Now
+	}
+	for _, capability := range opts.Capabilities {
+		if !slices.Contains(GetValidCapabilities(), string(capability)) {
+			return nil, fmt.Errorf("invalid capability: %s. Valid capabilities are: %s", capability, strings.Join(GetValidCapabilities(), ", "))
+		}
+	}
+
+	fs, err := manifest.LoadLocalFlagSet(path)
+	if err != nil {
+		return nil, err
+	}
+
+	loadedAt := opts.Now().UTC()
+	flags := make(map[string]*flagRecord, len(fs.Flags))
+	for _, flag := range fs.Flags {
+		flags[flag.Key] = &flagRecord{flag: flag, updatedAt: loadedAt}
+	}
+
+	return &Server{
+		manifestPath: path,
+		opts:         opts,
+		flags:        flags,
+		archived:     make(map[string]time.Time),
+	}, nil
+}
+
+// ServeHTTP implements http.Handler
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	logger.Default.Debug(fmt.Sprintf("%s %s", r.Method, r.URL.Path))
+
+	switch {
+	case r.URL.Path == manifestPath && r.Method == http.MethodGet:
+		if s.authorize(w, r, CapabilityRead) {
+			s.getManifest(w)
+		}
+	case r.URL.Path == flagsPath && r.Method == http.MethodPost:
+		if s.authorize(w, r, CapabilityWrite, CapabilityDelete) {
+			s.createFlag(w, r)
+		}
+	case strings.HasPrefix(r.URL.Path, flagsPath+"/") && r.Method == http.MethodPut:
+		if s.authorize(w, r, CapabilityWrite) {
+			s.updateFlag(w, r, strings.TrimPrefix(r.URL.Path, flagsPath+"/"))
+		}
+	case strings.HasPrefix(r.URL.Path, flagsPath+"/") && r.Method == http.MethodDelete:
+		if s.authorize(w, r, CapabilityDelete) {
+			s.archiveFlag(w, strings.TrimPrefix(r.URL.Path, flagsPath+"/"))
+		}
+	case r.URL.Path == manifestPath || r.URL.Path == flagsPath || strings.HasPrefix(r.URL.Path, flagsPath+"/"):
+		writeError(w, http.StatusMethodNotAllowed, fmt.Sprintf("Method %s is not allowed", r.Method), nil)
+	default:
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Path %s was not found", r.URL.Path), nil)
+	}
+}
+
+// authorize checks the bearer token and that it grants one of the required capabilities.
+// It writes the error response and returns false when the request is not allowed.
+func (s *Server) authorize(w http.ResponseWriter, r *http.Request, required ...Capability) bool {
+	if s.opts.AuthToken != "" {
+		header := r.Header.Get("Authorization")
+		if header == "" {
+			writeError(w, http.StatusUnauthorized, "Authorization header required", nil)
+			return false
+		}
+		token, found := strings.CutPrefix(header, "Bearer ")
+		if !found || token != s.opts.AuthToken {
+			writeError(w, http.StatusUnauthorized, "Invalid API token", nil)
+			return false
+		}
+	}
+
+	capabilities := make([]string, len(s.opts.Capabilities))
+	for i, capability := range s.opts.Capabilities {
+		capabilities[i] = string(capability)
+	}
+	w.Header().Set(CapabilitiesHeader, strings.Join(capabilities, ","))
+
+	for _, capability := range required {
+		if slices.Contains(s.opts.Capabilities, capability) {
+			return true
+		}
+	}
+	writeError(w, http.StatusForbidden, fmt.Sprintf("This API token does not have %s access.", required[0]), nil)
+	return false
+}
+
+func (s *Server) getManifest(w http.ResponseWriter) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	keys := make([]string, 0, len(s.flags))
+	for key := range s.flags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	envelope := syncclient.ManifestEnvelope{Flags: make([]syncclient.ManifestFlag, 0, len(keys))}
+	for _, key := range keys {
+		apiFlag, err := toAPIFlag(s.flags[key])
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err.Error(), nil)
+			return
+		}
+		envelope.Flags = append(envelope.Flags, apiFlag)
+	}
+
+	writeJSON(w, http.StatusOK, envelope)
+}
+
+func (s *Server) createFlag(w http.ResponseWriter, r *http.Request) {
+	body, details := decodeFlagBody(r, true)
+	if details != nil {
+		writeError(w, http.StatusBadRequest, "Validation failed.", details)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.archived[body.flag.Key]; ok {
+		writeError(w, http.StatusConflict,
+			fmt.Sprintf("Flag with key %q is archived. Restore it in the UI or choose a new key.", body.flag.Key),
+			[]syncclient.ErrorDetail{detail("key", "", "Flag exists but is archived. Restore it to reuse this key.")})
+		return
+	}
+	if _, ok := s.flags[body.flag.Key]; ok {
+		writeError(w, http.StatusConflict, fmt.Sprintf("Flag with key %q already exists.", body.flag.Key),
+			[]syncclient.ErrorDetail{detail("key", "", "Flag key is already in use.")})
+		return
+	}
+
+	record := &flagRecord{flag: body.flag, updatedAt: s.opts.Now().UTC()}
+	s.flags[body.flag.Key] = record
+	if err := s.persist(); err != nil {
+		delete(s.flags, body.flag.Key)
+		writeError(w, http.StatusInternalServerError, err.Error(), nil)
+		return
+	}
+
+	s.writeFlagResponse(w, http.StatusCreated, record)
+}
+
+func (s *Server) updateFlag(w http.ResponseWriter, r *http.Request, key string) {
+	body, details := decodeFlagBody(r, false)
+	if details != nil {
+		writeError(w, http.StatusBadRequest, "Validation failed.", details)
+		return
+	}
+	if body.flag.Key != key {
+		writeError(w, http.StatusBadRequest, "Flag key in path and payload must match.",
+			[]syncclient.ErrorDetail{detail("key", "", "Path key and payload key differ.")})
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.archived[key]; ok {
+		writeError(w, http.StatusConflict, fmt.Sprintf("Flag %q is archived. Restore it in the UI before updating.", key),
+			[]syncclient.ErrorDetail{detail("key", "", "Archived flags cannot be updated via the manifest API.")})
+		return
+	}
+	existing, ok := s.flags[key]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Flag %q was not found.", key), nil)
+		return
+	}
+	if existing.flag.Type != body.flag.Type {
+		writeError(w, http.StatusConflict,
+			fmt.Sprintf("Flag type cannot be changed from %s to %s.", existing.flag.Type, body.flag.Type), nil)
+		return
+	}
+
+	updated := *existing
+	if body.hasDescription {
+		updated.flag.Description = body.flag.Description
+	}
+	if body.hasDefault {
+		updated.flag.DefaultValue = body.flag.DefaultValue
+	}
+	if body.hasName {
+		updated.flag.Name = body.flag.Name
+	}
+	updated.updatedAt = s.opts.Now().UTC()
+
+	s.flags[key] = &updated
+	if err := s.persist(); err != nil {
+		s.flags[key] = existing
+		writeError(w, http.StatusInternalServerError, err.Error(), nil)
+		return
+	}
+
+	s.writeFlagResponse(w, http.StatusOK, &updated)
+}
+
+func (s *Server) archiveFlag(w http.ResponseWriter, key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.archived[key]; ok {
+		writeError(w, http.StatusConflict, fmt.Sprintf("Flag %q is already archived.", key),
+			[]syncclient.ErrorDetail{detail("key", "", "Flag is already archived. Restore it to use it again.")})
+		return
+	}
+	existing, ok := s.flags[key]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Flag %q was not found.", key), nil)
+		return
+	}
+
+	delete(s.flags, key)
+	if err := s.persist(); err != nil {
+		s.flags[key] = existing
+		writeError(w, http.StatusInternalServerError, err.Error(), nil)
+		return
+	}
+
+	archivedAt := s.opts.Now().UTC()
+	s.archived[key] = archivedAt
+	writeJSON(w, http.StatusOK, syncclient.ArchiveResponse{
+		Message:    fmt.Sprintf("Flag %q archived. Restore it using your management interface if needed.", key),
+		ArchivedAt: &archivedAt,
+	})
+}
+
+// persist writes the active flags back to the manifest file. The caller must hold the lock.
+func (s *Server) persist() error {
+	fs := flagset.Flagset{Flags: make([]flagset.Flag, 0, len(s.flags))}
+	for _, record := range s.flags {
+		fs.Flags = append(fs.Flags, record.flag)
+	}
+	if err := manifest.WriteLocal(s.manifestPath, fs); err != nil {
+		return fmt.Errorf("failed to write manifest: %w", err)
+	}
+	return nil
+}
+
+func (s *Server) writeFlagResponse(w http.ResponseWriter, status int, record *flagRecord) {
+	apiFlag, err := toAPIFlag(record)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error(), nil)
+		return
+	}
+	writeJSON(w, status, syncclient.ManifestFlagResponse{Flag: apiFlag, UpdatedAt: record.updatedAt})
+}
+
+// flagBody is a validated create or update request body
+type flagBody struct {
+	flag           flagset.Flag
+	hasName        bool
+	hasDescription bool
+	hasDefault     bool
+}
+
+// decodeFlagBody parses and validates a create or update request body.
+// It returns the validation error details when the body is invalid.
+func decodeFlagBody(r *http.Request, requireDefault bool) (*flagBody, []syncclient.ErrorDetail) {
+	var raw struct {
+		Key          string          `json:"key"`
+		Type         string          `json:"type"`
+		Name         *string         `json:"name"`
+		Description  *string         `json:"description"`
+		DefaultValue json.RawMessage `json:"defaultValue"`
+	}
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, []syncclient.ErrorDetail{detail("", "invalid_body", "Request body could not be read.")}
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&raw); err != nil {
+		return nil, []syncclient.ErrorDetail{detail("", "invalid_body", fmt.Sprintf("Request body is not valid: %v", err))}
+	}
+
+	var details []syncclient.ErrorDetail
+	if raw.Key == "" {
+		details = append(details, detail("key", "too_small", "Flag key is required."))
+	}
+	flagType, err := flagset.ParseFlagType(raw.Type)
+	if err != nil {
+		details = append(details, detail("type", "invalid_enum_value", fmt.Sprintf("Flag type %q is not supported.", raw.Type)))
+	}
+
+	body := &flagBody{flag: flagset.Flag{Key: raw.Key, Type: flagType}}
+	if raw.Name != nil {
+		body.flag.Name = *raw.Name
+		body.hasName = true
+	}
+	if raw.Description != nil {
+		body.flag.Description = *raw.Description
+		body.hasDescription = true
+	}
+
+	hasDefault := len(raw.DefaultValue) > 0 && !bytes.Equal(raw.DefaultValue, []byte("null"))
+	switch {
+	case !hasDefault && requireDefault:
+		details = append(details, detail("defaultValue", "invalid_type", "Default value is required."))
+	case hasDefault && err == nil:
+		var value any
+		if err := json.Unmarshal(raw.DefaultValue, &value); err != nil || !matchesType(value, flagType) {
+			details = append(details, detail("defaultValue", "invalid_type", fmt.Sprintf("Default value does not match flag type %s.", flagType)))
+		} else {
+			body.flag.DefaultValue = value
+			body.hasDefault = true
+		}
+	}
+
+	if len(details) > 0 {
+		return nil, details
+	}
+	return body, nil
+}
+
+// matchesType reports whether a JSON-decoded value is valid for the flag type
+func matchesType(value any, flagType flagset.FlagType) bool {
+	switch v := value.(type) {
+	case bool:
+		return flagType == flagset.BoolType
+	case string:
+		return flagType == flagset.StringType
+	case float64:
+		return flagType == flagset.FloatType || (flagType == flagset.IntType && v == float64(int64(v)))
+	case map[string]any:
+		return flagType == flagset.ObjectType
+	default:
+		return false
+	}
+}
+
+func toAPIFlag(record *flagRecord) (syncclient.ManifestFlag, error) {
+	valueJSON, err := json.Marshal(record.flag.DefaultValue)
+	if err != nil {
+		return syncclient.ManifestFlag{}, fmt.Errorf("failed to marshal defaultValue for flag %s: %w", record.flag.Key, err)
+	}
+	var defaultValue syncclient.FlagDefaultValue
+	if err := defaultValue.UnmarshalJSON(valueJSON); err != nil {
+		return syncclient.ManifestFlag{}, fmt.Errorf("failed to convert defaultValue for flag %s: %w", record.flag.Key, err)
+	}
+
+	apiFlag := syncclient.ManifestFlag{
+		Key:          record.flag.Key,
+		Type:         syncclient.ManifestFlagType(record.flag.Type.String()),
+		DefaultValue: defaultValue,
+	}
+	// The name defaults to the key when the flag does not have one
+	name := record.flag.Name
+	if name == "" {
+		name = record.flag.Key
+	}
+	apiFlag.Name = &name
+	if record.flag.Description != "" {
+		description := record.flag.Description
+		apiFlag.Description = &description
+	}
+	return apiFlag, nil
+}
+
+func detail(field, code, message string) syncclient.ErrorDetail {
+	d := syncclient.ErrorDetail{Message: &message}
+	if field != "" {
+		d.Field = &field
+	}
+	if code != "" {
+		d.Code = &code
+	}
+	return d
+}
+
+func writeError(w http.ResponseWriter, status int, message string, details []syncclient.ErrorDetail) {
+	var resp syncclient.ErrorResponse
+	resp.Error.Message = message
+	resp.Error.Status = status
+	if len(details) > 0 {
+		resp.Error.Details = &details
+	}
+	writeJSON(w, status, resp)
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		logger.Default.Debug(fmt.Sprintf("failed to write response: %v", err))
+	}
+}
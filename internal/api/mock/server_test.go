@@ -0,0 +1,272 @@
+package mock
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/open-feature/cli/internal/api/sync"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testManifest = `{
+	"$schema": "https://raw.githubusercontent.com/open-feature/cli/refs/heads/main/schema/v0/flag-manifest.json",
+	"flags": {
+		"search-rollout": {
+			"flagType": "boolean",
+			"defaultValue": false,
+			"description": "Enable the new search experience."
+		},
+		"welcome-banner": {
+			"flagType": "string",
+			"defaultValue": "control"
+		}
+	}
+}`
+
+var testNow = time.Date(2024, 3, 2, 9, 45, 3, 0, time.UTC)
+
+func newTestServer(t *testing.T, opts Options) *httptest.Server {
+	t.Helper()
+
+	fs := afero.NewMemMapFs()
+	filesystem.SetFileSystem(fs)
+	require.NoError(t, afero.WriteFile(fs, "flags.json", []byte(testManifest), 0o644))
+
+	if opts.Capabilities == nil {
+		opts.Capabilities = []Capability{CapabilityRead, CapabilityWrite, CapabilityDelete}
+	}
+	opts.Now = func() time.Time { return testNow }
+
+	server, err := NewServer("flags.json", opts)
+	require.NoError(t, err)
+
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+	return httpServer
+}
+
+func doRequest(t *testing.T, method, url, token, body string) (*http.Response, string) {
+	t.Helper()
+
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.True(t, json.Valid(respBody), "response should be JSON: %s", respBody)
+	return resp, string(respBody)
+}
+
+func TestServerSyncRoundTrip(t *testing.T) {
+	httpServer := newTestServer(t, Options{AuthToken: "secret"})
+
+	client, err := sync.NewClient(httpServer.URL, "secret")
+	require.NoError(t, err)
+
+	remote, err := client.PullFlags(context.Background())
+	require.NoError(t, err)
+	require.Len(t, remote.Flags, 2)
+
+	local := &flagset.Flagset{Flags: []flagset.Flag{
+		{Key: "search-rollout", Type: flagset.BoolType, DefaultValue: true, Description: "Enable the new search experience."},
+		{Key: "welcome-banner", Type: flagset.StringType, DefaultValue: "control"},
+		{Key: "max-items", Type: flagset.IntType, DefaultValue: 25},
+	}}
+	result, err := client.PushFlags(context.Background(), local, remote, false)
+	require.NoError(t, err)
+	assert.Len(t, result.Created, 1)
+	assert.Len(t, result.Updated, 1)
+
+	// Changes are written back to the manifest
+	fs, err := manifest.LoadFlagSet("flags.json", manifest.LoadOptions{})
+	require.NoError(t, err)
+	defaults := make(map[string]any)
+	for _, flag := range fs.Flags {
+		defaults[flag.Key] = flag.DefaultValue
+	}
+	assert.Equal(t, map[string]any{
+		"search-rollout": true,
+		"welcome-banner": "control",
+		"max-items":      float64(25),
+	}, defaults)
+}
+
+func TestServerArchivedKeys(t *testing.T) {
+	httpServer := newTestServer(t, Options{})
+
+	resp, body := doRequest(t, http.MethodDelete, httpServer.URL+"/openfeature/v0/manifest/flags/search-rollout", "", "")
+	require.Equal(t, http.StatusOK, resp.StatusCode, body)
+	assert.Equal(t, "read,write,delete", resp.Header.Get(CapabilitiesHeader))
+
+	resp, body = doRequest(t, http.MethodGet, httpServer.URL+"/openfeature/v0/manifest", "", "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.NotContains(t, body, "search-rollout")
+
+	resp, body = doRequest(t, http.MethodPost, httpServer.URL+"/openfeature/v0/manifest/flags", "",
+		`{"key":"search-rollout","type":"boolean","defaultValue":true}`)
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	assert.Contains(t, body, "is archived")
+
+	resp, body = doRequest(t, http.MethodPut, httpServer.URL+"/openfeature/v0/manifest/flags/search-rollout", "",
+		`{"key":"search-rollout","type":"boolean","defaultValue":true}`)
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	assert.Contains(t, body, "is archived")
+
+	resp, body = doRequest(t, http.MethodDelete, httpServer.URL+"/openfeature/v0/manifest/flags/search-rollout", "", "")
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	assert.Contains(t, body, "already archived")
+}
+
+func TestServerResponses(t *testing.T) {
+	tests := []struct {
+		name           string
+		opts           Options
+		method         string
+		path           string
+		token          string
+		body           string
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "manifest lists active flags",
+			method:         http.MethodGet,
+			path:           "/openfeature/v0/manifest",
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"key":"search-rollout"`,
+		},
+		{
+			name:           "missing token",
+			opts:           Options{AuthToken: "secret"},
+			method:         http.MethodGet,
+			path:           "/openfeature/v0/manifest",
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody:   "Authorization header required",
+		},
+		{
+			name:           "invalid token",
+			opts:           Options{AuthToken: "secret"},
+			method:         http.MethodGet,
+			path:           "/openfeature/v0/manifest",
+			token:          "wrong",
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody:   "Invalid API token",
+		},
+		{
+			name:           "read-only token cannot create",
+			opts:           Options{AuthToken: "secret", Capabilities: []Capability{CapabilityRead}},
+			method:         http.MethodPost,
+			path:           "/openfeature/v0/manifest/flags",
+			token:          "secret",
+			body:           `{"key":"new-flag","type":"boolean","defaultValue":true}`,
+			expectedStatus: http.StatusForbidden,
+			expectedBody:   "does not have write access",
+		},
+		{
+			name:           "create returns updatedAt",
+			method:         http.MethodPost,
+			path:           "/openfeature/v0/manifest/flags",
+			body:           `{"key":"new-flag","type":"float","defaultValue":0.5,"name":"New flag"}`,
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `"updatedAt":"2024-03-02T09:45:03Z"`,
+		},
+		{
+			name:           "create existing key",
+			method:         http.MethodPost,
+			path:           "/openfeature/v0/manifest/flags",
+			body:           `{"key":"search-rollout","type":"boolean","defaultValue":true}`,
+			expectedStatus: http.StatusConflict,
+			expectedBody:   "already exists",
+		},
+		{
+			name:           "create with mismatched default value",
+			method:         http.MethodPost,
+			path:           "/openfeature/v0/manifest/flags",
+			body:           `{"key":"new-flag","type":"integer","defaultValue":"five"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "does not match flag type integer",
+		},
+		{
+			name:           "create with unknown property",
+			method:         http.MethodPost,
+			path:           "/openfeature/v0/manifest/flags",
+			body:           `{"key":"new-flag","type":"boolean","defaultValue":true,"owner":"team"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "Validation failed.",
+		},
+		{
+			name:           "update with mismatched key",
+			method:         http.MethodPut,
+			path:           "/openfeature/v0/manifest/flags/search-rollout",
+			body:           `{"key":"other","type":"boolean","defaultValue":true}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "Flag key in path and payload must match.",
+		},
+		{
+			name:           "update missing flag",
+			method:         http.MethodPut,
+			path:           "/openfeature/v0/manifest/flags/missing",
+			body:           `{"key":"missing","type":"boolean","defaultValue":true}`,
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   `Flag \"missing\" was not found.`,
+		},
+		{
+			name:           "update without description keeps it",
+			method:         http.MethodPut,
+			path:           "/openfeature/v0/manifest/flags/search-rollout",
+			body:           `{"key":"search-rollout","type":"boolean","defaultValue":true}`,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"description":"Enable the new search experience."`,
+		},
+		{
+			name:           "update cannot change type",
+			method:         http.MethodPut,
+			path:           "/openfeature/v0/manifest/flags/search-rollout",
+			body:           `{"key":"search-rollout","type":"string","defaultValue":"on"}`,
+			expectedStatus: http.StatusConflict,
+			expectedBody:   "Flag type cannot be changed from boolean to string.",
+		},
+		{
+			name:           "delete archives the flag",
+			method:         http.MethodDelete,
+			path:           "/openfeature/v0/manifest/flags/welcome-banner",
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"archivedAt":"2024-03-02T09:45:03Z"`,
+		},
+		{
+			name:           "delete missing flag",
+			method:         http.MethodDelete,
+			path:           "/openfeature/v0/manifest/flags/missing",
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpServer := newTestServer(t, tt.opts)
+
+			resp, body := doRequest(t, tt.method, httpServer.URL+tt.path, tt.token, tt.body)
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode, body)
+			assert.Contains(t, body, tt.expectedBody)
+		})
+	}
+}
@@ -2,23 +2,30 @@
 package sync
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
 	"net/http"
+	"slices"
 	"time"
 
 	goretry "github.com/kriscoleman/GoRetry"
 	syncclient "github.com/open-feature/cli/internal/api/client"
+	"github.com/open-feature/cli/internal/credentials"
 	"github.com/open-feature/cli/internal/flagset"
 	"github.com/open-feature/cli/internal/logger"
 )
 
+// DefaultTimeout is the timeout of requests to the sync API
+const DefaultTimeout = 30 * time.Second
+
 // Client wraps the generated OpenAPI client with convenience methods
 type Client struct {
 	apiClient *syncclient.ClientWithResponses
-	authToken string
+	tokens    TokenSource
 }
 
 // httpError wraps an HTTP response status code for retry logic
@@ -59,23 +66,70 @@ func isTransientHTTPError(err error) bool {
 	return goretry.DefaultTransientErrorFunc(err)
 }
 
+// isRetryable determines if an error should trigger a retry. Besides transient errors,
+// requests rejected with 401 are retried when the token source can provide a new token.
+func (c *Client) isRetryable(err error) bool {
+	return c.refreshOnUnauthorized(err) || isTransientHTTPError(err)
+}
+
+// refreshOnUnauthorized refreshes the token after a 401 response and reports whether the request should be retried
+func (c *Client) refreshOnUnauthorized(err error) bool {
+	var httpErr *httpError
+	return errors.As(err, &httpErr) && httpErr.statusCode == http.StatusUnauthorized && c.tokens.Refresh()
+}
+
 // NewClient creates a new sync client
 func NewClient(baseURL string, authToken string) (*Client, error) {
+	logger.Default.Debug(fmt.Sprintf("Creating sync client for %s (auth token: %s)", baseURL, credentials.Redact(authToken)))
+	return NewClientWithTokenSource(baseURL, StaticToken(authToken), nil)
+}
+
+// NewClientWithTokenSource creates a new sync client that authenticates with tokens from the
+// token source. Requests rejected with 401 are retried once the token source has refreshed its token.
+// A nil httpClient uses a client with the default transport and a 30 second timeout.
+func NewClientWithTokenSource(baseURL string, tokens TokenSource, httpClient *http.Client) (*Client, error) {
+	apiClient, err := NewAPIClientWithTokenSource(baseURL, tokens, httpClient)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		apiClient: apiClient,
+		tokens:    tokens,
+	}, nil
+}
+
+// NewAPIClient creates a generated Manifest Management API client that sends the
+// auth token and standard headers with every request
+func NewAPIClient(baseURL string, authToken string) (*syncclient.ClientWithResponses, error) {
+	return NewAPIClientWithTokenSource(baseURL, StaticToken(authToken), nil)
+}
+
+// NewAPIClientWithTokenSource creates a generated Manifest Management API client that sends
+// a token from the token source and standard headers with every request. A nil httpClient
+// uses a client with the default transport and a 30 second timeout.
+func NewAPIClientWithTokenSource(baseURL string, tokens TokenSource, httpClient *http.Client) (*syncclient.ClientWithResponses, error) {
 	// Create a custom HTTP client with timeout
-	httpClient := &http.Client{
-		Timeout: 30 * time.Second,
+	if httpClient == nil {
+		httpClient = &http.Client{
+			Timeout: DefaultTimeout,
+		}
 	}
 
 	// Add authentication if provided
 	var opts []syncclient.ClientOption
 	opts = append(opts, syncclient.WithHTTPClient(httpClient))
 
-	if authToken != "" {
-		opts = append(opts, syncclient.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+	opts = append(opts, syncclient.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+		authToken, err := tokens.Token(ctx)
+		if err != nil {
+			return err
+		}
+		if authToken != "" {
 			req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", authToken))
-			return nil
-		}))
-	}
+		}
+		return nil
+	}))
 
 	// Add standard headers
 	opts = append(opts, syncclient.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
@@ -89,10 +143,7 @@ func NewClient(baseURL string, authToken string) (*Client, error) {
 		return nil, fmt.Errorf("failed to create API client: %w", err)
 	}
 
-	return &Client{
-		apiClient: apiClient,
-		authToken: authToken,
-	}, nil
+	return apiClient, nil
 }
 
 // PushResult contains the results of a push operation
@@ -100,15 +151,72 @@ type PushResult struct {
 	Created   []flagset.Flag
 	Updated   []flagset.Flag
 	Unchanged []flagset.Flag
+	// Remote holds the remote flags after the push, as a pull would return them. It is nil for dry runs.
+	Remote *flagset.Flagset
+}
+
+// Validators identify a version of the manifest for conditional requests
+type Validators struct {
+	ETag         string
+	LastModified string
+}
+
+// ValidatorsFromResponse returns the validators sent with a response
+func ValidatorsFromResponse(resp *http.Response) Validators {
+	return Validators{
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+	}
+}
+
+// SetConditionalHeaders adds If-None-Match and If-Modified-Since headers for the validators to the request
+func (v Validators) SetConditionalHeaders(req *http.Request) {
+	if v.ETag != "" {
+		req.Header.Set("If-None-Match", v.ETag)
+	}
+	if v.LastModified != "" {
+		req.Header.Set("If-Modified-Since", v.LastModified)
+	}
 }
 
 // PullFlags fetches flags from the remote API
 func (c *Client) PullFlags(ctx context.Context) (*flagset.Flagset, error) {
+	flags, _, err := c.PullFlagsIfModified(ctx, Validators{})
+	return flags, err
+}
+
+// PullFlagsIfModified fetches flags from the remote API unless the manifest still matches the
+// given validators, in which case it returns nil flags. The validators of the fetched manifest
+// are returned for the next request.
+func (c *Client) PullFlagsIfModified(ctx context.Context, validators Validators) (*flagset.Flagset, Validators, error) {
+	flags, resp, err := c.pullFlags(ctx, validators)
+	if err != nil || resp == nil {
+		return nil, Validators{}, err
+	}
+	return flags, ValidatorsFromResponse(resp), nil
+}
+
+func (c *Client) pullFlags(ctx context.Context, validators Validators) (*flagset.Flagset, *http.Response, error) {
 	logger.Default.Debug("Fetching flags using sync API client")
 
-	resp, err := c.apiClient.GetOpenfeatureV0ManifestWithResponse(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch manifest: %w", err)
+	var resp *syncclient.GetOpenfeatureV0ManifestResponse
+	err := goretry.IfNeededWithContext(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = c.apiClient.GetOpenfeatureV0ManifestWithResponse(ctx, func(_ context.Context, req *http.Request) error {
+			validators.SetConditionalHeaders(req)
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to fetch manifest: %w", err)
+		}
+		if resp.HTTPResponse != nil && resp.HTTPResponse.StatusCode == http.StatusUnauthorized {
+			return &httpError{statusCode: http.StatusUnauthorized, message: "authentication failed"}
+		}
+		return nil
+	}, goretry.WithTransientErrorFunc(c.refreshOnUnauthorized))
+	var httpErr *httpError
+	if err != nil && !errors.As(err, &httpErr) {
+		return nil, nil, err
 	}
 
 	// Debug: log HTTP response details
@@ -121,24 +229,29 @@ func (c *Client) PullFlags(ctx context.Context) (*flagset.Flagset, error) {
 
 	// Check for successful status code
 	if resp.HTTPResponse == nil {
-		return nil, fmt.Errorf("received nil HTTP response")
+		return nil, nil, fmt.Errorf("received nil HTTP response")
+	}
+
+	if resp.HTTPResponse.StatusCode == http.StatusNotModified {
+		logger.Default.Debug("Manifest not modified since the last pull")
+		return nil, resp.HTTPResponse, nil
 	}
 
 	if resp.HTTPResponse.StatusCode < 200 || resp.HTTPResponse.StatusCode >= 300 {
 		// Try to parse error response
 		if resp.JSON401 != nil {
-			return nil, fmt.Errorf("authentication failed: %s", resp.JSON401.Error.Message)
+			return nil, nil, fmt.Errorf("authentication failed: %s", resp.JSON401.Error.Message)
 		} else if resp.JSON403 != nil {
-			return nil, fmt.Errorf("authorization failed: %s", resp.JSON403.Error.Message)
+			return nil, nil, fmt.Errorf("authorization failed: %s", resp.JSON403.Error.Message)
 		} else if resp.JSON500 != nil {
-			return nil, fmt.Errorf("server error: %s", resp.JSON500.Error.Message)
+			return nil, nil, fmt.Errorf("server error: %s", resp.JSON500.Error.Message)
 		}
-		return nil, fmt.Errorf("unexpected status code %d: %s", resp.HTTPResponse.StatusCode, string(resp.Body))
+		return nil, nil, fmt.Errorf("unexpected status code %d: %s", resp.HTTPResponse.StatusCode, string(resp.Body))
 	}
 
 	// Parse successful response
 	if resp.JSON200 == nil {
-		return nil, fmt.Errorf("expected manifest data but got none")
+		return nil, nil, fmt.Errorf("expected manifest data but got none")
 	}
 
 	// Convert from API model to internal flagset model
@@ -147,7 +260,7 @@ func (c *Client) PullFlags(ctx context.Context) (*flagset.Flagset, error) {
 		// Parse flag type from string
 		flagType, err := flagset.ParseFlagType(string(apiFlag.Type))
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse flag type for %s: %w", apiFlag.Key, err)
+			return nil, nil, fmt.Errorf("failed to parse flag type for %s: %w", apiFlag.Key, err)
 		}
 
 		flag := flagset.Flag{
@@ -159,14 +272,27 @@ func (c *Client) PullFlags(ctx context.Context) (*flagset.Flagset, error) {
 		if apiFlag.Description != nil {
 			flag.Description = *apiFlag.Description
 		}
+		// Providers default the name to the key, which is the same as not setting one
+		if apiFlag.Name != nil && *apiFlag.Name != apiFlag.Key {
+			flag.Name = *apiFlag.Name
+		}
 
 		// Convert defaultValue from union type by marshaling and unmarshaling
-		defaultValueJSON, err := json.Marshal(apiFlag.DefaultValue)
-		if err != nil {
-			return nil, fmt.Errorf("failed to marshal defaultValue for flag %s: %w", flag.Key, err)
-		}
-		if err := json.Unmarshal(defaultValueJSON, &flag.DefaultValue); err != nil {
-			return nil, fmt.Errorf("failed to parse defaultValue for flag %s: %w", flag.Key, err)
+		if flagType == flagset.FloatType {
+			// Read floats through the number branch so a non-numeric default value is rejected
+			value, err := apiFlag.DefaultValue.AsFlagDefaultValue4()
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to parse defaultValue for flag %s: %w", flag.Key, err)
+			}
+			flag.DefaultValue = value
+		} else {
+			defaultValueJSON, err := json.Marshal(apiFlag.DefaultValue)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to marshal defaultValue for flag %s: %w", flag.Key, err)
+			}
+			if err := json.Unmarshal(defaultValueJSON, &flag.DefaultValue); err != nil {
+				return nil, nil, fmt.Errorf("failed to parse defaultValue for flag %s: %w", flag.Key, err)
+			}
 		}
 
 		flags = append(flags, flag)
@@ -174,7 +300,7 @@ func (c *Client) PullFlags(ctx context.Context) (*flagset.Flagset, error) {
 
 	logger.Default.Debug(fmt.Sprintf("Successfully pulled %d flags", len(flags)))
 
-	return &flagset.Flagset{Flags: flags}, nil
+	return &flagset.Flagset{Flags: flags}, resp.HTTPResponse, nil
 }
 
 // PushFlags fetches remote flags, compares with local flags, and intelligently
@@ -237,7 +363,7 @@ func (c *Client) PushFlags(ctx context.Context, localFlags *flagset.Flagset, rem
 			}
 
 			return c.handleFlagResponse(resp.HTTPResponse, resp.Body, flagKey, "create")
-		}, goretry.WithTransientErrorFunc(isTransientHTTPError))
+		}, goretry.WithTransientErrorFunc(c.isRetryable))
 		if err != nil {
 			return nil, err
 		}
@@ -270,20 +396,49 @@ func (c *Client) PushFlags(ctx context.Context, localFlags *flagset.Flagset, rem
 			}
 
 			return c.handleFlagResponse(resp.HTTPResponse, resp.Body, flagKey, "update")
-		}, goretry.WithTransientErrorFunc(isTransientHTTPError))
+		}, goretry.WithTransientErrorFunc(c.isRetryable))
 		if err != nil {
 			return nil, err
 		}
 		result.Updated = append(result.Updated, flag)
 	}
 
+	result.Remote = pushedFlags(remoteFlags, localFlags)
 	return result, nil
 }
 
+// pushedFlags returns the remote flags with the local flags applied, keeping only the fields the API stores.
+// Names and descriptions that a local flag leaves empty are not sent, so the remote ones are kept.
+func pushedFlags(remoteFlags, localFlags *flagset.Flagset) *flagset.Flagset {
+	flags := make(map[string]flagset.Flag, len(remoteFlags.Flags))
+	for _, flag := range remoteFlags.Flags {
+		flags[flag.Key] = flag
+	}
+	for _, local := range localFlags.Flags {
+		remote := flags[local.Key]
+		flags[local.Key] = flagset.Flag{
+			Key:          local.Key,
+			Type:         local.Type,
+			DefaultValue: local.DefaultValue,
+			Name:         cmp.Or(local.Name, remote.Name),
+			Description:  cmp.Or(local.Description, remote.Description),
+		}
+	}
+
+	pushed := &flagset.Flagset{Flags: make([]flagset.Flag, 0, len(flags))}
+	for _, key := range slices.Sorted(maps.Keys(flags)) {
+		pushed.Flags = append(pushed.Flags, flags[key])
+	}
+	return pushed
+}
+
 // convertFlagToAPIBody converts internal flag to POST API body format
 func (c *Client) convertFlagToAPIBody(flag flagset.Flag) (syncclient.PostOpenfeatureV0ManifestFlagsJSONRequestBody, error) {
 	// Convert flag type to API enum
 	flagType := syncclient.PostOpenfeatureV0ManifestFlagsJSONBodyType(flag.Type.String())
+	if !flagType.Valid() {
+		return syncclient.PostOpenfeatureV0ManifestFlagsJSONRequestBody{}, fmt.Errorf("unsupported flag type %q", flag.Type.String())
+	}
 
 	// Marshal and unmarshal the defaultValue through JSON to properly set the union type
 	defaultValueJSON, err := json.Marshal(flag.DefaultValue)
@@ -303,7 +458,10 @@ func (c *Client) convertFlagToAPIBody(flag flagset.Flag) (syncclient.PostOpenfea
 		DefaultValue: defaultValue,
 	}
 
-	// Add description if present
+	// Add name and description if present
+	if flag.Name != "" {
+		body.Name = &flag.Name
+	}
 	if flag.Description != "" {
 		body.Description = &flag.Description
 	}
@@ -315,6 +473,9 @@ func (c *Client) convertFlagToAPIBody(flag flagset.Flag) (syncclient.PostOpenfea
 func (c *Client) convertFlagToPutBody(flag flagset.Flag) (syncclient.PutOpenfeatureV0ManifestFlagsKeyJSONRequestBody, error) {
 	// Convert flag type to API enum
 	flagType := syncclient.PutOpenfeatureV0ManifestFlagsKeyJSONBodyType(flag.Type.String())
+	if !flagType.Valid() {
+		return syncclient.PutOpenfeatureV0ManifestFlagsKeyJSONRequestBody{}, fmt.Errorf("unsupported flag type %q", flag.Type.String())
+	}
 
 	// Marshal and unmarshal the defaultValue through JSON to properly set the union type
 	defaultValueJSON, err := json.Marshal(flag.DefaultValue)
@@ -334,7 +495,10 @@ func (c *Client) convertFlagToPutBody(flag flagset.Flag) (syncclient.PutOpenfeat
 		DefaultValue: &defaultValue,
 	}
 
-	// Add description if present
+	// Add name and description if present. Without a local name the remote name is kept.
+	if flag.Name != "" {
+		body.Name = &flag.Name
+	}
 	if flag.Description != "" {
 		body.Description = &flag.Description
 	}
@@ -377,7 +541,8 @@ func (c *Client) handleFlagResponse(resp *http.Response, body []byte, flagKey st
 	}
 }
 
-// flagsEqual compares two flags to determine if they are effectively identical
+// flagsEqual compares a local flag with a remote flag to determine if they are effectively identical.
+// A local flag without a name leaves the name to the remote, e.g. one set in the vendor UI.
 func flagsEqual(a, b flagset.Flag) bool {
 	// Compare key, type, and defaultValue
 	if a.Key != b.Key || a.Type != b.Type {
@@ -401,6 +566,12 @@ func flagsEqual(a, b flagset.Flag) bool {
 		return false
 	}
 
+	// Compare names, unless the local flag does not manage its name
+	if a.Name != "" && a.Name != b.Name {
+		logger.Default.Debug(fmt.Sprintf("Flag %s name differs:\n  Local: %q\n  Remote: %q", a.Key, a.Name, b.Name))
+		return false
+	}
+
 	// Compare descriptions (both empty or identical)
 	if a.Description != b.Description {
 		logger.Default.Debug(fmt.Sprintf("Flag %s description differs:\n  Local: %q\n  Remote: %q", a.Key, a.Description, b.Description))
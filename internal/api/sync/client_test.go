@@ -0,0 +1,239 @@
+package sync
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/h2non/gock"
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFloatFlagRoundTrip(t *testing.T) {
+	t.Run("pushes float flags with the float type", func(t *testing.T) {
+		defer gock.Off()
+
+		var created, updated map[string]any
+		captureBody := func(target *map[string]any) func(*http.Request, *gock.Request) (bool, error) {
+			return func(req *http.Request, _ *gock.Request) (bool, error) {
+				body, err := io.ReadAll(req.Body)
+				if err != nil {
+					return false, err
+				}
+				return true, json.Unmarshal(body, target)
+			}
+		}
+
+		gock.New("https://api.example.com").
+			Post("/openfeature/v0/manifest/flags").
+			AddMatcher(captureBody(&created)).
+			Reply(201).
+			JSON(map[string]any{"flag": map[string]any{"key": "discount", "type": "float", "defaultValue": 0.15}})
+
+		gock.New("https://api.example.com").
+			Put("/openfeature/v0/manifest/flags/ratio").
+			AddMatcher(captureBody(&updated)).
+			Reply(200).
+			JSON(map[string]any{"flag": map[string]any{"key": "ratio", "type": "float", "defaultValue": 2.0}})
+
+		client, err := NewClient("https://api.example.com", "")
+		require.NoError(t, err)
+
+		localFlags := &flagset.Flagset{
+			Flags: []flagset.Flag{
+				{Key: "discount", Type: flagset.FloatType, DefaultValue: 0.15},
+				{Key: "ratio", Type: flagset.FloatType, DefaultValue: 2.0},
+			},
+		}
+		remoteFlags := &flagset.Flagset{
+			Flags: []flagset.Flag{
+				{Key: "ratio", Type: flagset.FloatType, DefaultValue: 1.5},
+			},
+		}
+
+		result, err := client.PushFlags(t.Context(), localFlags, remoteFlags, false)
+		require.NoError(t, err)
+		assert.Len(t, result.Created, 1)
+		assert.Len(t, result.Updated, 1)
+		assert.True(t, gock.IsDone(), "All expected requests should be made")
+
+		assert.Equal(t, map[string]any{"key": "discount", "type": "float", "defaultValue": 0.15}, created)
+		assert.Equal(t, map[string]any{"key": "ratio", "type": "float", "defaultValue": float64(2)}, updated)
+	})
+
+	t.Run("pulls float flags with the float type", func(t *testing.T) {
+		defer gock.Off()
+
+		gock.New("https://api.example.com").
+			Get("/openfeature/v0/manifest").
+			Reply(200).
+			JSON(map[string]any{
+				"flags": []map[string]any{
+					{"key": "discount", "type": "float", "defaultValue": 0.15},
+					{"key": "ratio", "type": "float", "defaultValue": 2},
+					{"key": "max-items", "type": "integer", "defaultValue": 25},
+				},
+			})
+
+		client, err := NewClient("https://api.example.com", "")
+		require.NoError(t, err)
+
+		flags, err := client.PullFlags(t.Context())
+		require.NoError(t, err)
+		require.Len(t, flags.Flags, 3)
+
+		assert.Equal(t, flagset.Flag{Key: "discount", Type: flagset.FloatType, DefaultValue: 0.15}, flags.Flags[0])
+		assert.Equal(t, flagset.Flag{Key: "ratio", Type: flagset.FloatType, DefaultValue: float64(2)}, flags.Flags[1])
+		assert.Equal(t, flagset.IntType, flags.Flags[2].Type)
+
+		// Pulled flags compare equal to the local flags they were pushed from
+		assert.True(t, flagsEqual(flagset.Flag{Key: "ratio", Type: flagset.FloatType, DefaultValue: 2.0}, flags.Flags[1]))
+	})
+
+	t.Run("rejects a non-numeric float default value", func(t *testing.T) {
+		defer gock.Off()
+
+		gock.New("https://api.example.com").
+			Get("/openfeature/v0/manifest").
+			Reply(200).
+			JSON(map[string]any{
+				"flags": []map[string]any{
+					{"key": "discount", "type": "float", "defaultValue": "0.15"},
+				},
+			})
+
+		client, err := NewClient("https://api.example.com", "")
+		require.NoError(t, err)
+
+		_, err = client.PullFlags(t.Context())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to parse defaultValue for flag discount")
+	})
+}
+
+func TestConvertFlagRejectsUnsupportedType(t *testing.T) {
+	client, err := NewClient("https://api.example.com", "")
+	require.NoError(t, err)
+
+	flag := flagset.Flag{Key: "unknown", Type: flagset.UnknownFlagType, DefaultValue: true}
+
+	_, err = client.convertFlagToAPIBody(flag)
+	assert.ErrorContains(t, err, "unsupported flag type")
+
+	_, err = client.convertFlagToPutBody(flag)
+	assert.ErrorContains(t, err, "unsupported flag type")
+}
+
+func TestPullAndPushPreserveNames(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.example.com").
+		Get("/openfeature/v0/manifest").
+		Reply(200).
+		JSON(map[string]any{
+			"flags": []map[string]any{
+				{"key": "search-rollout", "name": "Search rollout", "type": "boolean", "defaultValue": false},
+				{"key": "max-items", "name": "max-items", "type": "integer", "defaultValue": 25},
+			},
+		})
+
+	client, err := NewClient("https://api.example.com", "")
+	require.NoError(t, err)
+
+	remote, err := client.PullFlags(t.Context())
+	require.NoError(t, err)
+	require.Len(t, remote.Flags, 2)
+	assert.Equal(t, "Search rollout", remote.Flags[0].Name)
+	assert.Empty(t, remote.Flags[1].Name, "a name equal to the key is treated as unset")
+
+	// Pushing the pulled flags back is a no-op
+	result, err := client.PushFlags(t.Context(), remote, remote, false)
+	require.NoError(t, err)
+	assert.Empty(t, result.Updated)
+	assert.Empty(t, result.Created)
+
+	// Renaming a flag locally sends the new name
+	local := &flagset.Flagset{Flags: []flagset.Flag{remote.Flags[0], remote.Flags[1]}}
+	local.Flags[0].Name = "Search experience rollout"
+
+	var updated map[string]any
+	gock.New("https://api.example.com").
+		Put("/openfeature/v0/manifest/flags/search-rollout").
+		AddMatcher(func(req *http.Request, _ *gock.Request) (bool, error) {
+			body, err := io.ReadAll(req.Body)
+			if err != nil {
+				return false, err
+			}
+			return true, json.Unmarshal(body, &updated)
+		}).
+		Reply(200).
+		JSON(map[string]any{"flag": map[string]any{"key": "search-rollout", "type": "boolean", "defaultValue": false}})
+
+	result, err = client.PushFlags(t.Context(), local, remote, false)
+	require.NoError(t, err)
+	assert.Len(t, result.Updated, 1)
+	assert.Equal(t, "Search experience rollout", updated["name"])
+}
+
+func TestPushFlagsReturnsRemoteFlags(t *testing.T) {
+	client, err := NewClient("https://api.example.com", "")
+	require.NoError(t, err)
+
+	remote := &flagset.Flagset{Flags: []flagset.Flag{
+		{Key: "search-rollout", Name: "Search rollout", Type: flagset.BoolType, DefaultValue: false, Description: "Search"},
+		{Key: "max-items", Type: flagset.IntType, DefaultValue: 25},
+	}}
+	local := &flagset.Flagset{Flags: []flagset.Flag{
+		{Key: "search-rollout", Type: flagset.BoolType, DefaultValue: false, Tags: []string{"web"}},
+	}}
+
+	result, err := client.PushFlags(t.Context(), local, remote, true)
+	require.NoError(t, err)
+	assert.Nil(t, result.Remote, "dry runs do not change the remote")
+
+	// Flags that are not pushed stay, and unset names and descriptions are kept by the remote
+	defer gock.Off()
+	gock.New("https://api.example.com").
+		Put("/openfeature/v0/manifest/flags/search-rollout").
+		Reply(200).
+		JSON(map[string]any{"flag": map[string]any{"key": "search-rollout", "type": "boolean", "defaultValue": false}})
+
+	result, err = client.PushFlags(t.Context(), local, remote, false)
+	require.NoError(t, err)
+	assert.Equal(t, []flagset.Flag{
+		{Key: "max-items", Type: flagset.IntType, DefaultValue: 25},
+		{Key: "search-rollout", Name: "Search rollout", Type: flagset.BoolType, DefaultValue: false, Description: "Search"},
+	}, result.Remote.Flags)
+}
+
+func TestPullFlagsIfModified(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.example.com").
+		Get("/openfeature/v0/manifest").
+		Reply(200).
+		SetHeader("ETag", `"v1"`).
+		SetHeader("Last-Modified", "Sun, 01 Jun 2025 12:00:00 GMT").
+		JSON(map[string]any{"flags": []map[string]any{{"key": "checkout", "type": "boolean", "defaultValue": true}}})
+	gock.New("https://api.example.com").
+		Get("/openfeature/v0/manifest").
+		MatchHeader("If-None-Match", `"v1"`).
+		MatchHeader("If-Modified-Since", "Sun, 01 Jun 2025 12:00:00 GMT").
+		Reply(304)
+
+	client, err := NewClient("https://api.example.com", "")
+	require.NoError(t, err)
+
+	flags, validators, err := client.PullFlagsIfModified(t.Context(), Validators{})
+	require.NoError(t, err)
+	require.Len(t, flags.Flags, 1)
+	assert.Equal(t, Validators{ETag: `"v1"`, LastModified: "Sun, 01 Jun 2025 12:00:00 GMT"}, validators)
+
+	flags, _, err = client.PullFlagsIfModified(t.Context(), validators)
+	require.NoError(t, err)
+	assert.Nil(t, flags, "an unchanged manifest is not returned")
+	assert.True(t, gock.IsDone())
+}
@@ -0,0 +1,167 @@
+package sync
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	gosync "sync"
+	"time"
+
+	"github.com/open-feature/cli/internal/logger"
+)
+
+// tokenExpiryLeeway is how long before its expiry an access token is replaced, so that it
+// does not expire while a request is in flight
+const tokenExpiryLeeway = 30 * time.Second
+
+// TokenSource provides the bearer tokens sent to a provider
+type TokenSource interface {
+	// Token returns the current token, fetching a new one when needed. An empty token
+	// means requests are sent without authentication.
+	Token(ctx context.Context) (string, error)
+	// Refresh discards the current token after the provider rejected it, and reports
+	// whether the next call to Token can return a different one
+	Refresh() bool
+}
+
+// StaticToken returns a token source that always returns the given token
+func StaticToken(token string) TokenSource {
+	return staticToken(token)
+}
+
+type staticToken string
+
+func (t staticToken) Token(_ context.Context) (string, error) {
+	return string(t), nil
+}
+
+func (t staticToken) Refresh() bool {
+	return false
+}
+
+// OAuth2Config configures the OAuth2 client credentials grant
+type OAuth2Config struct {
+	TokenURL     string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+	// HTTPClient sends token requests. When nil, a client with the default transport and
+	// a 30 second timeout is used.
+	HTTPClient *http.Client
+}
+
+// oauth2TokenSource fetches access tokens with the client credentials grant and caches them until they expire
+type oauth2TokenSource struct {
+	config     OAuth2Config
+	httpClient *http.Client
+	// now returns the current time and defaults to time.Now
+	now func() time.Time
+
+	mu     gosync.Mutex
+	token  string
+	expiry time.Time
+}
+
+// NewOAuth2TokenSource returns a token source for the OAuth2 client credentials grant.
+// Tokens are cached until shortly before they expire, or until the provider rejects them.
+func NewOAuth2TokenSource(config OAuth2Config) TokenSource {
+	httpClient := config.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: DefaultTimeout}
+	}
+	return &oauth2TokenSource{
+		config:     config,
+		httpClient: httpClient,
+		now:        time.Now,
+	}
+}
+
+func (s *oauth2TokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Tokens without an expiry are kept until the provider rejects them
+	if s.token != "" && (s.expiry.IsZero() || s.now().Before(s.expiry.Add(-tokenExpiryLeeway))) {
+		return s.token, nil
+	}
+
+	token, expiresIn, err := s.fetch(ctx)
+	if err != nil {
+		return "", err
+	}
+	s.token = token
+	s.expiry = time.Time{}
+	if expiresIn > 0 {
+		s.expiry = s.now().Add(time.Duration(expiresIn) * time.Second)
+	}
+	return s.token, nil
+}
+
+func (s *oauth2TokenSource) Refresh() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	logger.Default.Debug("Discarding OAuth2 access token rejected by the provider")
+	s.token = ""
+	return true
+}
+
+// fetch requests a new access token from the token endpoint and returns it with its lifetime in seconds
+func (s *oauth2TokenSource) fetch(ctx context.Context) (string, int64, error) {
+	form := url.Values{"grant_type": {"client_credentials"}}
+	if len(s.config.Scopes) > 0 {
+		form.Set("scope", strings.Join(s.config.Scopes, " "))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.config.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid OAuth2 token URL: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	// Client credentials are form-encoded before being used for basic auth, as RFC 6749 requires
+	req.SetBasicAuth(url.QueryEscape(s.config.ClientID), url.QueryEscape(s.config.ClientSecret))
+
+	logger.Default.Debug(fmt.Sprintf("Requesting OAuth2 access token from %s for client %s", s.config.TokenURL, s.config.ClientID))
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to request OAuth2 access token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to read OAuth2 token response: %w", err)
+	}
+
+	var tokenResp struct {
+		AccessToken      string `json:"access_token"`
+		TokenType        string `json:"token_type"`
+		ExpiresIn        int64  `json:"expires_in"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err := json.Unmarshal(body, &tokenResp); err != nil && resp.StatusCode == http.StatusOK {
+		return "", 0, fmt.Errorf("failed to parse OAuth2 token response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		if tokenResp.Error != "" {
+			return "", 0, fmt.Errorf("OAuth2 token request failed (status %d): %s", resp.StatusCode, strings.TrimSpace(tokenResp.Error+" "+tokenResp.ErrorDescription))
+		}
+		return "", 0, fmt.Errorf("OAuth2 token request failed (status %d): %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	if tokenResp.AccessToken == "" {
+		return "", 0, fmt.Errorf("OAuth2 token response has no access_token")
+	}
+	if tokenResp.TokenType != "" && !strings.EqualFold(tokenResp.TokenType, "bearer") {
+		return "", 0, fmt.Errorf("unsupported OAuth2 token type %q", tokenResp.TokenType)
+	}
+
+	logger.Default.Debug(fmt.Sprintf("Received OAuth2 access token expiring in %ds", tokenResp.ExpiresIn))
+	return tokenResp.AccessToken, tokenResp.ExpiresIn, nil
+}
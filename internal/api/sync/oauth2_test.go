@@ -0,0 +1,164 @@
+package sync
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	gosync "sync"
+	"testing"
+	"time"
+
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tokenEndpoint is a stand-in OAuth2 authorization server issuing numbered access tokens
+type tokenEndpoint struct {
+	*httptest.Server
+	mu     gosync.Mutex
+	issued int
+}
+
+func newTokenEndpoint(t *testing.T, expiresIn int) *tokenEndpoint {
+	t.Helper()
+
+	endpoint := &tokenEndpoint{}
+	endpoint.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		clientID, secret, ok := r.BasicAuth()
+		if !ok || clientID != "cli" || secret != "s3cret" || r.FormValue("grant_type") != "client_credentials" {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"error":"invalid_client","error_description":"Client authentication failed"}`)
+			return
+		}
+		if r.FormValue("scope") != "flags:read flags:write" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"error":"invalid_scope"}`)
+			return
+		}
+
+		endpoint.mu.Lock()
+		endpoint.issued++
+		token := fmt.Sprintf("token-%d", endpoint.issued)
+		endpoint.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"access_token": token, "token_type": "Bearer", "expires_in": expiresIn})
+	}))
+	t.Cleanup(endpoint.Close)
+	return endpoint
+}
+
+func (e *tokenEndpoint) config() OAuth2Config {
+	return OAuth2Config{TokenURL: e.URL + "/oauth/token", ClientID: "cli", ClientSecret: "s3cret", Scopes: []string{"flags:read", "flags:write"}}
+}
+
+func TestOAuth2TokenSource(t *testing.T) {
+	endpoint := newTokenEndpoint(t, 300)
+	tokens := NewOAuth2TokenSource(endpoint.config()).(*oauth2TokenSource)
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	tokens.now = func() time.Time { return now }
+
+	token, err := tokens.Token(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, "token-1", token)
+
+	// Tokens are cached until shortly before they expire
+	now = now.Add(4 * time.Minute)
+	token, err = tokens.Token(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, "token-1", token)
+
+	now = now.Add(40 * time.Second)
+	token, err = tokens.Token(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, "token-2", token)
+
+	assert.True(t, tokens.Refresh())
+	token, err = tokens.Token(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, "token-3", token)
+
+	t.Run("invalid client", func(t *testing.T) {
+		config := endpoint.config()
+		config.ClientSecret = "wrong"
+
+		_, err := NewOAuth2TokenSource(config).Token(t.Context())
+		assert.ErrorContains(t, err, "OAuth2 token request failed (status 401): invalid_client Client authentication failed")
+	})
+}
+
+// newOAuth2Provider starts a stand-in provider that only accepts the most recently issued token
+func newOAuth2Provider(t *testing.T, endpoint *tokenEndpoint) (*httptest.Server, *[]string) {
+	t.Helper()
+
+	var mu gosync.Mutex
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, r.Method+" "+r.Header.Get("Authorization"))
+		mu.Unlock()
+
+		endpoint.mu.Lock()
+		current := fmt.Sprintf("Bearer token-%d", endpoint.issued)
+		endpoint.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.Header.Get("Authorization") != current {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"error":{"message":"token expired","status":401}}`)
+			return
+		}
+		switch {
+		case r.Method == http.MethodGet:
+			fmt.Fprint(w, `{"flags":[{"key":"checkout","type":"boolean","defaultValue":true}]}`)
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/flags"):
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"flag":{"key":"banner","type":"string","defaultValue":"on"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func TestClientRefreshesOAuth2TokenOnUnauthorized(t *testing.T) {
+	endpoint := newTokenEndpoint(t, 3600)
+	provider, requests := newOAuth2Provider(t, endpoint)
+
+	tokens := NewOAuth2TokenSource(endpoint.config())
+	client, err := NewClientWithTokenSource(provider.URL, tokens, nil)
+	require.NoError(t, err)
+
+	remote, err := client.PullFlags(t.Context())
+	require.NoError(t, err)
+	require.Len(t, remote.Flags, 1)
+
+	// The provider revokes token-1 as soon as another token is issued
+	_, err = NewOAuth2TokenSource(endpoint.config()).Token(t.Context())
+	require.NoError(t, err)
+
+	local := &flagset.Flagset{Flags: []flagset.Flag{{Key: "banner", Type: flagset.StringType, DefaultValue: "on"}}}
+	result, err := client.PushFlags(t.Context(), local, remote, false)
+	require.NoError(t, err)
+	assert.Len(t, result.Created, 1)
+	assert.Equal(t, []string{"GET Bearer token-1", "POST Bearer token-1", "POST Bearer token-3"}, *requests)
+
+	// Pulls refresh the token as well
+	_, err = NewOAuth2TokenSource(endpoint.config()).Token(t.Context())
+	require.NoError(t, err)
+	_, err = client.PullFlags(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, "GET Bearer token-5", (*requests)[len(*requests)-1])
+
+	t.Run("static tokens are not retried", func(t *testing.T) {
+		client, err := NewClient(provider.URL, "token-1")
+		require.NoError(t, err)
+
+		_, err = client.PullFlags(t.Context())
+		assert.ErrorContains(t, err, "authentication failed: token expired")
+	})
+}
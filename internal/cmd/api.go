@@ -0,0 +1,27 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func GetAPICmd() *cobra.Command {
+	apiCmd := &cobra.Command{
+		Use:   "api",
+		Short: "Work with Manifest Management API providers",
+		Long:  `Commands for working with providers that implement the Manifest Management API defined in api/v0/sync.yaml.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+		SilenceErrors:              true,
+		SilenceUsage:               true,
+		DisableSuggestions:         false,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	// Add subcommands
+	apiCmd.AddCommand(GetAPIConformanceCmd())
+
+	addStabilityInfo(apiCmd)
+
+	return apiCmd
+}
@@ -0,0 +1,140 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/open-feature/cli/internal/api/conformance"
+	"github.com/open-feature/cli/internal/api/sync"
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/transport"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+func GetAPIConformanceCmd() *cobra.Command {
+	apiConformanceCmd := &cobra.Command{
+		Use:   "conformance",
+		Short: "Check that a provider implements the Manifest Management API",
+		Long: `Run a scripted scenario against a provider and report whether it meets each
+requirement of the Manifest Management API defined in api/v0/sync.yaml.
+
+The scenario creates, updates, conflicts, deletes and re-fetches flags whose keys
+start with "openfeature-conformance-". Flags created during the run are deleted
+before the command exits, so the token must have read, write and delete access.
+
+Providers that archive deleted flags are expected to reject re-creating an archived
+key with 409 Conflict. The check is skipped for providers that hard-delete flags.
+
+The command exits with a non-zero status when at least one check fails, which makes
+it suitable for a provider's CI pipeline.`,
+		Example: `  # Check a provider
+  openfeature api conformance --provider-url https://api.example.com --auth-token secret-token
+
+  # Check the local server started with 'openfeature serve'
+  openfeature api conformance --provider-url http://localhost:8080
+
+  # Produce a machine-readable report
+  openfeature api conformance --provider-url http://localhost:8080 --output json`,
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "api.conformance")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			providerURL := config.GetFlagSourceURL(cmd)
+			outputFormat, _ := cmd.Flags().GetString(config.OutputFlagName)
+
+			if providerURL == "" {
+				return fmt.Errorf("provider URL is required. Please provide --provider-url")
+			}
+			if outputFormat != "table" && outputFormat != "json" {
+				return fmt.Errorf("invalid output format: %s. Valid formats are: table, json", outputFormat)
+			}
+
+			parsedURL, err := url.Parse(providerURL)
+			if err != nil {
+				return fmt.Errorf("invalid provider URL: %w", err)
+			}
+			if parsedURL.Scheme != "http" && parsedURL.Scheme != "https" {
+				return fmt.Errorf("unsupported URL scheme: %s. Supported schemes are http:// and https://", parsedURL.Scheme)
+			}
+
+			backendOpts, err := resolveBackendOptions(cmd, providerURL)
+			if err != nil {
+				return err
+			}
+
+			httpClient, err := transport.NewClient(backendOpts.Transport, sync.DefaultTimeout)
+			if err != nil {
+				return fmt.Errorf("invalid transport settings: %w", err)
+			}
+
+			client, err := sync.NewAPIClientWithTokenSource(providerURL, backendOpts.TokenSource, httpClient)
+			if err != nil {
+				return fmt.Errorf("failed to create API client: %w", err)
+			}
+
+			report := conformance.Run(cmd.Context(), client, providerURL, conformance.Options{})
+
+			if outputFormat == "json" {
+				if err := renderConformanceJSON(cmd, report); err != nil {
+					return err
+				}
+			} else {
+				displayConformanceReport(report)
+			}
+
+			if failed := report.Count(conformance.StatusFail); failed > 0 {
+				return fmt.Errorf("%d conformance check(s) failed", failed)
+			}
+
+			return nil
+		},
+	}
+
+	// Add command-specific flags
+	config.AddAPIConformanceFlags(apiConformanceCmd)
+	addStabilityInfo(apiConformanceCmd)
+
+	return apiConformanceCmd
+}
+
+// displayConformanceReport prints a table with the result of each conformance check
+func displayConformanceReport(report *conformance.Report) {
+	pterm.DefaultSection.Printfln("Conformance report for %s", report.ProviderURL)
+
+	tableData := pterm.TableData{
+		{"Requirement", "Status", "Message"},
+	}
+	for _, result := range report.Results {
+		status := pterm.Green(string(result.Status))
+		switch result.Status {
+		case conformance.StatusFail:
+			status = pterm.Red(string(result.Status))
+		case conformance.StatusSkip:
+			status = pterm.Yellow(string(result.Status))
+		}
+		tableData = append(tableData, []string{result.Requirement, status, result.Message})
+	}
+	_ = pterm.DefaultTable.WithHasHeader().WithData(tableData).Render()
+
+	summary := fmt.Sprintf("%d passed, %d failed, %d skipped",
+		report.Count(conformance.StatusPass), report.Count(conformance.StatusFail), report.Count(conformance.StatusSkip))
+	if report.Count(conformance.StatusFail) > 0 {
+		pterm.Error.Println(summary)
+	} else {
+		pterm.Success.Println(summary)
+	}
+}
+
+// renderConformanceJSON prints the conformance report as JSON
+func renderConformanceJSON(cmd *cobra.Command, report *conformance.Report) error {
+	jsonBytes, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshaling JSON output: %w", err)
+	}
+
+	fmt.Fprintln(cmd.OutOrStdout(), string(jsonBytes))
+	return nil
+}
@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/open-feature/cli/internal/api/mock"
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIConformanceCmd(t *testing.T) {
+	tests := []struct {
+		name           string
+		capabilities   []mock.Capability
+		args           []string
+		expectedError  string
+		validateOutput func(t *testing.T, output string)
+	}{
+		{
+			name:         "conformant provider",
+			capabilities: []mock.Capability{mock.CapabilityRead, mock.CapabilityWrite, mock.CapabilityDelete},
+			args:         []string{"--output", "json"},
+			validateOutput: func(t *testing.T, output string) {
+				var report map[string]any
+				require.NoError(t, json.Unmarshal([]byte(output), &report))
+				results := report["results"].([]any)
+				require.NotEmpty(t, results)
+				for _, result := range results {
+					assert.Equal(t, "pass", result.(map[string]any)["status"])
+				}
+			},
+		},
+		{
+			name:          "read-only token fails",
+			capabilities:  []mock.Capability{mock.CapabilityRead},
+			args:          []string{"--output", "json"},
+			expectedError: "1 conformance check(s) failed",
+		},
+		{
+			name:          "invalid output format",
+			capabilities:  []mock.Capability{mock.CapabilityRead},
+			args:          []string{"--output", "xml"},
+			expectedError: "invalid output format",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			filesystem.SetFileSystem(fs)
+			t.Cleanup(func() { filesystem.SetFileSystem(afero.NewOsFs()) })
+			require.NoError(t, afero.WriteFile(fs, "flags.json", []byte(`{"flags": {}}`), 0o644))
+
+			server, err := mock.NewServer("flags.json", mock.Options{AuthToken: "secret", Capabilities: tt.capabilities})
+			require.NoError(t, err)
+			httpServer := httptest.NewServer(server)
+			defer httpServer.Close()
+
+			cmd := GetAPICmd()
+			config.AddRootFlags(cmd)
+
+			buf := &bytes.Buffer{}
+			cmd.SetOut(buf)
+			cmd.SetArgs(append([]string{"conformance", "--provider-url", httpServer.URL, "--auth-token", "secret"}, tt.args...))
+
+			err = cmd.Execute()
+
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+			if tt.validateOutput != nil {
+				tt.validateOutput(t, buf.String())
+			}
+		})
+	}
+}
+
+func TestAPIConformanceCmdRequiresProviderURL(t *testing.T) {
+	cmd := GetAPICmd()
+	config.AddRootFlags(cmd)
+	cmd.SetArgs([]string{"conformance"})
+
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "provider URL is required")
+}
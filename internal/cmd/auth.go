@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/open-feature/cli/internal/api/sync"
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/credentials"
+	"github.com/open-feature/cli/internal/logger"
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/open-feature/cli/internal/transport"
+	"github.com/spf13/cobra"
+)
+
+// credentialHelpersConfigKey is the config file key mapping provider hosts to credential helpers
+const credentialHelpersConfigKey = "credential-helpers"
+
+// resolveBackendOptions returns the backend options for connecting to the provider, with the
+// transport settings for its host and authentication as selected by --auth-mode.
+// In oauth2 mode, access tokens are only sent to http:// and https:// providers.
+func resolveBackendOptions(cmd *cobra.Command, providerURL string) (manifest.BackendOptions, error) {
+	transportOpts, err := resolveTransport(cmd, providerURL)
+	if err != nil {
+		return manifest.BackendOptions{}, err
+	}
+
+	switch mode := config.GetAuthMode(cmd); mode {
+	case "bearer":
+		token, err := resolveAuthToken(cmd, providerURL)
+		if err != nil {
+			return manifest.BackendOptions{}, err
+		}
+		return manifest.BackendOptions{AuthToken: token, TokenSource: sync.StaticToken(token), Transport: transportOpts}, nil
+	case "oauth2":
+		tokens, err := resolveOAuth2TokenSource(cmd)
+		if err != nil {
+			return manifest.BackendOptions{}, err
+		}
+		return manifest.BackendOptions{TokenSource: tokens, Transport: transportOpts}, nil
+	default:
+		return manifest.BackendOptions{}, fmt.Errorf("invalid auth mode: %s. Valid modes are: bearer, oauth2", mode)
+	}
+}
+
+// resolveAuthToken returns the auth token for the provider from --auth-token, the
+// OPENFEATURE_AUTH_TOKEN environment variable, --auth-token-file or a credential helper.
+func resolveAuthToken(cmd *cobra.Command, providerURL string) (string, error) {
+	token, err := credentials.Resolve(cmd.Context(), credentials.Options{
+		Token:       config.GetAuthToken(cmd),
+		TokenFile:   config.GetAuthTokenFile(cmd),
+		Helper:      credentialHelper(cmd, providerURL),
+		ProviderURL: providerURL,
+	})
+	if err != nil {
+		return "", fmt.Errorf("error resolving auth token: %w", err)
+	}
+	return token, nil
+}
+
+// resolveOAuth2TokenSource returns a token source for the OAuth2 client credentials flow. The client
+// secret is read from OPENFEATURE_OAUTH2_CLIENT_SECRET, --oauth2-client-secret-file or the credential
+// helper of the token endpoint host, so that it never has to be passed on the command line.
+func resolveOAuth2TokenSource(cmd *cobra.Command) (sync.TokenSource, error) {
+	tokenURL := config.GetOAuth2TokenURL(cmd)
+	if tokenURL == "" {
+		return nil, fmt.Errorf("OAuth2 token URL is required. Please provide --oauth2-token-url")
+	}
+	clientID := config.GetOAuth2ClientID(cmd)
+	if clientID == "" {
+		return nil, fmt.Errorf("OAuth2 client ID is required. Please provide --oauth2-client-id")
+	}
+
+	secret, err := credentials.Resolve(cmd.Context(), credentials.Options{
+		EnvVar:      credentials.ClientSecretEnvVar,
+		TokenFile:   config.GetOAuth2ClientSecretFile(cmd),
+		Helper:      credentialHelper(cmd, tokenURL),
+		ProviderURL: tokenURL,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error resolving OAuth2 client secret: %w", err)
+	}
+	if secret == "" {
+		return nil, fmt.Errorf("OAuth2 client secret is required. Please set %s or provide --oauth2-client-secret-file", credentials.ClientSecretEnvVar)
+	}
+
+	// The token endpoint may be on another host, with its own transport settings
+	transportOpts, err := resolveTransport(cmd, tokenURL)
+	if err != nil {
+		return nil, err
+	}
+	httpClient, err := transport.NewClient(transportOpts, sync.DefaultTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transport settings for the OAuth2 token endpoint: %w", err)
+	}
+
+	return sync.NewOAuth2TokenSource(sync.OAuth2Config{
+		TokenURL:     tokenURL,
+		ClientID:     clientID,
+		ClientSecret: secret,
+		Scopes:       config.GetOAuth2Scopes(cmd),
+		HTTPClient:   httpClient,
+	}), nil
+}
+
+// credentialHelper returns --credential-helper, or else the helper configured for the host of the URL
+func credentialHelper(cmd *cobra.Command, rawURL string) string {
+	if helper := config.GetCredentialHelper(cmd); helper != "" {
+		return helper
+	}
+	return configuredCredentialHelper(rawURL)
+}
+
+// configuredCredentialHelper returns the credential helper configured for the host of the
+// provider URL under credential-helpers in the config file
+func configuredCredentialHelper(providerURL string) string {
+	u, err := url.Parse(providerURL)
+	if err != nil || u.Host == "" {
+		return ""
+	}
+
+	v, err := readConfigFile()
+	if err != nil {
+		logger.Default.Debug(fmt.Sprintf("Not looking up a credential helper: %v", err))
+		return ""
+	}
+	// Config keys are case-insensitive, like host names
+	return v.GetStringMapString(credentialHelpersConfigKey)[strings.ToLower(u.Host)]
+}
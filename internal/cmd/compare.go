@@ -3,12 +3,14 @@ package cmd
 import (
 	"encoding/json"
 	"fmt"
-	"os"
+	"html"
 	"reflect"
+	"slices"
 	"sort"
 	"strings"
 
 	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/logger"
 	"github.com/open-feature/cli/internal/manifest"
 	"github.com/pterm/pterm"
 	"github.com/spf13/cobra"
@@ -29,7 +31,24 @@ Examples:
   openfeature compare --manifest local.json --against main.json
 
   # Preview what will change when pushing to remote
-  openfeature compare --manifest local.json --against remote.json --reverse`,
+  openfeature compare --manifest local.json --against remote.json --reverse
+
+  # Show the drift between the repository and a live environment
+  openfeature compare --manifest flags.json --against https://flags.example.com --reverse
+
+  # Fail when a change would break generated code, e.g. to gate pull requests
+  openfeature compare --manifest flags.json --against main.json --fail-on breaking
+
+A removed and an added flag are reported as a rename when the added flag names the removed one
+in its renamedFrom field, or when both have the same type, the same default value and similar
+descriptions.
+
+Each change is classified by severity: removing or renaming a flag or changing its type is breaking,
+changing its default value is potentially breaking, and anything else is safe.
+
+The target can be a local file or any source supported by pull: a sync API base URL, an http(s) URL
+of a manifest file, or an s3://, git+https://, git+ssh://, git+file://, oci:// or file:// URL.
+Remote manifests are normalized like pulled manifests before they are compared.`,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			return initializeConfig(cmd, "compare")
 		},
@@ -40,6 +59,7 @@ Examples:
 			outputFormat, _ := cmd.Flags().GetString("output")
 			ignorePatterns, _ := cmd.Flags().GetStringArray("ignore")
 			reverse, _ := cmd.Flags().GetBool("reverse")
+			failOn, _ := cmd.Flags().GetString("fail-on")
 
 			// Validate flags
 			if sourcePath == "" || targetPath == "" {
@@ -52,16 +72,31 @@ Examples:
 					outputFormat, strings.Join(manifest.GetValidOutputFormats(), ", "))
 			}
 
+			var failOnSeverity manifest.Severity
+			if failOn != "" {
+				var err error
+				if failOnSeverity, err = manifest.ParseSeverity(failOn); err != nil {
+					return fmt.Errorf("invalid --fail-on value: %w", err)
+				}
+			}
+
 			// Load manifests
-			sourceManifest, err := loadManifest(sourcePath)
+			sourceManifest, err := manifest.LoadManifest(sourcePath, manifestLoadOptions(cmd))
 			if err != nil {
 				return fmt.Errorf("error loading source manifest: %w", err)
 			}
 
-			targetManifest, err := loadManifest(targetPath)
+			targetManifest, err := loadCompareTarget(cmd, targetPath)
 			if err != nil {
 				return fmt.Errorf("error loading target manifest: %w", err)
 			}
+			if manifest.IsRemoteSource(targetPath) {
+				// Normalize the local manifest like the pulled one, so that only real differences are reported
+				sourceManifest, err = manifest.NormalizeManifest(sourceManifest)
+				if err != nil {
+					return fmt.Errorf("error loading source manifest: %w", err)
+				}
+			}
 
 			// Compare manifests with ignore patterns
 			// By default: Compare(target, source) shows what HAS changed (target is old, source is new)
@@ -70,68 +105,105 @@ Examples:
 			if reverse {
 				changes, err = manifest.Compare(sourceManifest, targetManifest, manifest.CompareOptions{
 					IgnorePatterns: ignorePatterns,
+					Filter:         tagFilterOptions(cmd),
+					DetectRenames:  true,
 				})
 			} else {
 				changes, err = manifest.Compare(targetManifest, sourceManifest, manifest.CompareOptions{
 					IgnorePatterns: ignorePatterns,
+					Filter:         tagFilterOptions(cmd),
+					DetectRenames:  true,
 				})
 			}
 			if err != nil {
 				return fmt.Errorf("error comparing manifests: %w", err)
 			}
 
-			// No changes
-			if len(changes) == 0 {
-				pterm.Success.Println("No differences found between the manifests.")
-				return nil
+			if err := renderDiff(changes, manifest.OutputFormat(outputFormat), cmd); err != nil {
+				return err
 			}
 
-			// Render differences based on the output format
-			switch manifest.OutputFormat(outputFormat) {
-			case manifest.OutputFormatFlat:
-				return renderFlatDiff(changes, cmd)
-			case manifest.OutputFormatJSON:
-				return renderJSONDiff(changes, cmd)
-			case manifest.OutputFormatYAML:
-				return renderYAMLDiff(changes, cmd)
-			default:
-				return renderTreeDiff(changes, cmd)
+			// Gate on the severity of the changes after rendering them, so that the report shows why
+			if failOnSeverity != "" {
+				failing := 0
+				for _, change := range changes {
+					if change.Severity.AtLeast(failOnSeverity) {
+						failing++
+					}
+				}
+				if failing > 0 {
+					return fmt.Errorf("found %d change(s) with severity %s or higher", failing, failOnSeverity)
+				}
 			}
+			return nil
 		},
 	}
 
 	// Add flags specific to compare command
-	compareCmd.Flags().StringP("against", "a", "", "Path to the target manifest file to compare against")
+	compareCmd.Flags().StringP("against", "a", "", "Path to the target manifest file, or URL of a provider or remote manifest, to compare against")
 	compareCmd.Flags().StringP("output", "o", string(manifest.OutputFormatTree),
 		fmt.Sprintf("Output format. Valid formats: %s", strings.Join(manifest.GetValidOutputFormats(), ", ")))
 	compareCmd.Flags().StringArrayP("ignore", "i", []string{},
 		"Field pattern to ignore during comparison (can be specified multiple times). "+
 			"Supports shorthand (e.g., 'description') and full paths with wildcards (e.g., 'flags.*.description', 'metadata.*')")
+	compareCmd.Flags().String("fail-on", "",
+		fmt.Sprintf("Exit with an error when a change has this severity or higher. Valid severities: %s",
+			strings.Join(manifest.GetValidSeverities(), ", ")))
 	compareCmd.Flags().Bool("reverse", false,
 		"Reverse comparison direction. Shows what WILL change when manifest is pushed to target (sending perspective) "+
 			"instead of what HAS changed in manifest compared to target (receiving perspective)")
 
+	config.AddTagFilterFlags(compareCmd)
+	config.AddRemoteSourceFlags(compareCmd)
+	config.AddProfileFlag(compareCmd)
+
 	// Mark required flags
 	_ = compareCmd.MarkFlagRequired("against")
 
 	return compareCmd
 }
 
-// loadManifest loads and unmarshals a manifest file from the given path
-func loadManifest(path string) (*manifest.Manifest, error) {
-	// Read file
-	data, err := os.ReadFile(path)
-	if err != nil {
-		return nil, fmt.Errorf("error reading file: %w", err)
+// renderDiff renders the changes in the given output format
+func renderDiff(changes []manifest.Change, outputFormat manifest.OutputFormat, cmd *cobra.Command) error {
+	// Markdown and HTML always render a report, so that a comment can also tell that there are no differences
+	switch outputFormat {
+	case manifest.OutputFormatMarkdown:
+		return renderMarkdownDiff(changes, cmd)
+	case manifest.OutputFormatHTML:
+		return renderHTMLDiff(changes, cmd)
 	}
 
-	// Unmarshal JSON
-	var m manifest.Manifest
-	if err := json.Unmarshal(data, &m); err != nil {
-		return nil, fmt.Errorf("error unmarshaling JSON: %w", err)
+	// No changes
+	if len(changes) == 0 {
+		pterm.Success.Println("No differences found between the manifests.")
+		return nil
 	}
 
-	return &m, nil
+	switch outputFormat {
+	case manifest.OutputFormatFlat:
+		return renderFlatDiff(changes, cmd)
+	case manifest.OutputFormatJSON:
+		return renderJSONDiff(changes, cmd)
+	case manifest.OutputFormatYAML:
+		return renderYAMLDiff(changes, cmd)
+	default:
+		return renderTreeDiff(changes, cmd)
+	}
+}
+
+// loadCompareTarget loads the manifest to compare against. Remote sources are pulled through
+// the same backends as pull, authenticating and connecting with the settings for their host.
+func loadCompareTarget(cmd *cobra.Command, target string) (*manifest.Manifest, error) {
+	if !manifest.IsRemoteSource(target) {
+		return manifest.LoadManifest(target, manifestLoadOptions(cmd))
+	}
+
+	backendOpts, err := resolveBackendOptions(cmd, target)
+	if err != nil {
+		return nil, err
+	}
+	logger.Default.Debug(fmt.Sprintf("Pulling target manifest from %s", target))
+	return manifest.PullManifest(cmd.Context(), target, backendOpts)
 }
 
 // renderTreeDiff renders changes with tree-structured inline differences
@@ -142,6 +214,7 @@ func renderTreeDiff(changes []manifest.Change, cmd *cobra.Command) error {
 	var (
 		additions     []manifest.Change
 		removals      []manifest.Change
+		renames       []manifest.Change
 		modifications []manifest.Change
 	)
 
@@ -151,6 +224,8 @@ func renderTreeDiff(changes []manifest.Change, cmd *cobra.Command) error {
 			additions = append(additions, change)
 		case "remove":
 			removals = append(removals, change)
+		case "rename":
+			renames = append(renames, change)
 		case "change":
 			modifications = append(modifications, change)
 		}
@@ -161,7 +236,7 @@ func renderTreeDiff(changes []manifest.Change, cmd *cobra.Command) error {
 		pterm.FgGreen.Println("◆ Additions:")
 		for _, change := range additions {
 			flagName := strings.TrimPrefix(change.Path, "flags.")
-			pterm.FgGreen.Printf("  + %s\n", flagName)
+			pterm.FgGreen.Printf("  + %s%s\n", flagName, severityLabel(change.Severity))
 			valueJSON, _ := json.MarshalIndent(change.NewValue, "    ", "  ")
 			fmt.Printf("    %s\n", valueJSON)
 		}
@@ -173,19 +248,33 @@ func renderTreeDiff(changes []manifest.Change, cmd *cobra.Command) error {
 		pterm.FgRed.Println("◆ Removals:")
 		for _, change := range removals {
 			flagName := strings.TrimPrefix(change.Path, "flags.")
-			pterm.FgRed.Printf("  - %s\n", flagName)
+			pterm.FgRed.Printf("  - %s%s\n", flagName, severityLabel(change.Severity))
 			valueJSON, _ := json.MarshalIndent(change.OldValue, "    ", "  ")
 			fmt.Printf("    %s\n", valueJSON)
 		}
 		fmt.Println()
 	}
 
+	// Print renames
+	if len(renames) > 0 {
+		pterm.FgCyan.Println("◆ Renames:")
+		for _, change := range renames {
+			flagName := strings.TrimPrefix(change.Path, "flags.")
+			oldName := strings.TrimPrefix(change.OldPath, "flags.")
+			pterm.FgCyan.Printf("  → %s → %s%s\n", oldName, flagName, severityLabel(change.Severity))
+			for _, fc := range renameFieldChanges(flagName, change) {
+				fmt.Printf("    • %s: %s → %s\n", fc.Field, fc.OldValue, fc.NewValue)
+			}
+		}
+		fmt.Println()
+	}
+
 	// Print modifications
 	if len(modifications) > 0 {
 		pterm.FgYellow.Println("◆ Modifications:")
 		for _, change := range modifications {
 			flagName := strings.TrimPrefix(change.Path, "flags.")
-			pterm.FgYellow.Printf("  ~ %s\n", flagName)
+			pterm.FgYellow.Printf("  ~ %s%s\n", flagName, severityLabel(change.Severity))
 
 			// Show field-level diff
 			fieldChanges := getFieldChanges(flagName, change.OldValue, change.NewValue)
@@ -208,6 +297,43 @@ func renderTreeDiff(changes []manifest.Change, cmd *cobra.Command) error {
 	return nil
 }
 
+// renameFieldChanges returns the field-level changes of a renamed flag. The renamedFrom field is
+// left out, as it only records the rename itself.
+func renameFieldChanges(flagName string, change manifest.Change) []fieldChange {
+	var changes []fieldChange
+	for _, fc := range getFieldChanges(flagName, change.OldValue, change.NewValue) {
+		if fc.Field != "renamedFrom" {
+			changes = append(changes, fc)
+		}
+	}
+	return changes
+}
+
+// severityLabel returns the label appended to changes in the tree and flat formats, which only
+// call out changes that may break consumers
+func severityLabel(severity manifest.Severity) string {
+	if severity == manifest.SeveritySafe || severity == "" {
+		return ""
+	}
+	return fmt.Sprintf(" [%s]", severity)
+}
+
+// severitySummary counts the changes of each severity, e.g. "1 breaking, 2 safe"
+func severitySummary(changes []manifest.Change) string {
+	counts := make(map[manifest.Severity]int)
+	for _, change := range changes {
+		counts[change.Severity]++
+	}
+
+	var parts []string
+	for _, severity := range manifest.GetValidSeverities() {
+		if count := counts[manifest.Severity(severity)]; count > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", count, severity))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
 // fieldChange represents a change to a specific field
 type fieldChange struct {
 	Field    string
@@ -317,11 +443,13 @@ func renderFlatDiff(changes []manifest.Change, cmd *cobra.Command) error {
 		flagName := strings.TrimPrefix(change.Path, "flags.")
 		switch change.Type {
 		case "add":
-			pterm.FgGreen.Printf("+ %s\n", flagName)
+			pterm.FgGreen.Printf("+ %s%s\n", flagName, severityLabel(change.Severity))
 		case "remove":
-			pterm.FgRed.Printf("- %s\n", flagName)
+			pterm.FgRed.Printf("- %s%s\n", flagName, severityLabel(change.Severity))
+		case "rename":
+			pterm.FgCyan.Printf("→ %s → %s%s\n", strings.TrimPrefix(change.OldPath, "flags."), flagName, severityLabel(change.Severity))
 		case "change":
-			pterm.FgYellow.Printf("~ %s\n", flagName)
+			pterm.FgYellow.Printf("~ %s%s\n", flagName, severityLabel(change.Severity))
 		}
 	}
 
@@ -335,6 +463,7 @@ func renderJSONDiff(changes []manifest.Change, cmd *cobra.Command) error {
 		TotalChanges  int               `json:"totalChanges" yaml:"totalChanges"`
 		Additions     []manifest.Change `json:"additions" yaml:"additions"`
 		Removals      []manifest.Change `json:"removals" yaml:"removals"`
+		Renames       []manifest.Change `json:"renames" yaml:"renames"`
 		Modifications []manifest.Change `json:"modifications" yaml:"modifications"`
 	}
 
@@ -348,6 +477,8 @@ func renderJSONDiff(changes []manifest.Change, cmd *cobra.Command) error {
 			output.Additions = append(output.Additions, change)
 		case "remove":
 			output.Removals = append(output.Removals, change)
+		case "rename":
+			output.Renames = append(output.Renames, change)
 		case "change":
 			output.Modifications = append(output.Modifications, change)
 		}
@@ -371,6 +502,7 @@ func renderYAMLDiff(changes []manifest.Change, cmd *cobra.Command) error {
 		TotalChanges  int               `json:"totalChanges" yaml:"totalChanges"`
 		Additions     []manifest.Change `json:"additions" yaml:"additions"`
 		Removals      []manifest.Change `json:"removals" yaml:"removals"`
+		Renames       []manifest.Change `json:"renames" yaml:"renames"`
 		Modifications []manifest.Change `json:"modifications" yaml:"modifications"`
 	}
 
@@ -384,6 +516,8 @@ func renderYAMLDiff(changes []manifest.Change, cmd *cobra.Command) error {
 			output.Additions = append(output.Additions, change)
 		case "remove":
 			output.Removals = append(output.Removals, change)
+		case "rename":
+			output.Renames = append(output.Renames, change)
 		case "change":
 			output.Modifications = append(output.Modifications, change)
 		}
@@ -399,3 +533,260 @@ func renderYAMLDiff(changes []manifest.Change, cmd *cobra.Command) error {
 	fmt.Println(string(yamlBytes))
 	return nil
 }
+
+// diffReport groups changes for the Markdown and HTML output formats
+type diffReport struct {
+	TotalChanges  int
+	Summary       string
+	Additions     []diffFlag
+	Removals      []diffFlag
+	Renames       []diffRename
+	Modifications []diffModification
+}
+
+// diffFlag describes an added or removed flag
+type diffFlag struct {
+	Key          string
+	Type         string
+	DefaultValue string
+	Description  string
+}
+
+// diffRename describes a renamed flag with its field-level changes
+type diffRename struct {
+	Key    string
+	From   string
+	Fields []fieldChange
+}
+
+// diffModification describes a modified flag with its field-level changes
+type diffModification struct {
+	Key      string
+	Severity manifest.Severity
+	Fields   []fieldChange
+}
+
+// newDiffReport groups the changes, sorted by flag key so that reports of the same changes are identical
+func newDiffReport(changes []manifest.Change) diffReport {
+	sorted := slices.Clone(changes)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Path < sorted[j].Path
+	})
+
+	report := diffReport{TotalChanges: len(changes), Summary: severitySummary(changes)}
+	for _, change := range sorted {
+		flagName := strings.TrimPrefix(change.Path, "flags.")
+		switch change.Type {
+		case "add":
+			report.Additions = append(report.Additions, newDiffFlag(flagName, change.NewValue))
+		case "remove":
+			report.Removals = append(report.Removals, newDiffFlag(flagName, change.OldValue))
+		case "rename":
+			report.Renames = append(report.Renames, diffRename{
+				Key:    flagName,
+				From:   strings.TrimPrefix(change.OldPath, "flags."),
+				Fields: renameFieldChanges(flagName, change),
+			})
+		case "change":
+			fields := getFieldChanges(flagName, change.OldValue, change.NewValue)
+			if len(fields) == 0 {
+				// Fallback to the whole flag if we can't parse
+				fields = []fieldChange{{Field: "(flag)", OldValue: formatFieldValue(change.OldValue), NewValue: formatFieldValue(change.NewValue)}}
+			}
+			report.Modifications = append(report.Modifications, diffModification{Key: flagName, Severity: change.Severity, Fields: fields})
+		}
+	}
+	return report
+}
+
+func newDiffFlag(key string, value any) diffFlag {
+	flag := diffFlag{Key: key}
+	fields, _ := value.(map[string]any)
+	if flagType, ok := fields["flagType"].(string); ok {
+		flag.Type = flagType
+	}
+	if defaultValue, ok := fields["defaultValue"]; ok {
+		flag.DefaultValue = formatFieldValue(defaultValue)
+	}
+	if description, ok := fields["description"].(string); ok {
+		flag.Description = description
+	}
+	return flag
+}
+
+// renderMarkdownDiff renders changes as Markdown tables, e.g. for pull request comments
+func renderMarkdownDiff(changes []manifest.Change, cmd *cobra.Command) error {
+	report := newDiffReport(changes)
+	w := cmd.OutOrStdout()
+
+	fmt.Fprintln(w, "## Flag manifest changes")
+	fmt.Fprintln(w)
+	if report.TotalChanges == 0 {
+		fmt.Fprintln(w, "No differences found between the manifests.")
+		return nil
+	}
+	fmt.Fprintf(w, "Found %d difference(s) between manifests: %s.\n", report.TotalChanges, report.Summary)
+
+	writeFlags := func(title string, flags []diffFlag) {
+		if len(flags) == 0 {
+			return
+		}
+		fmt.Fprintf(w, "\n### %s\n\n", title)
+		fmt.Fprintln(w, "| Flag | Type | Default value | Description |")
+		fmt.Fprintln(w, "| --- | --- | --- | --- |")
+		for _, flag := range flags {
+			fmt.Fprintf(w, "| %s | %s | %s | %s |\n", markdownCode(flag.Key), markdownText(flag.Type),
+				markdownCode(flag.DefaultValue), markdownText(flag.Description))
+		}
+	}
+	writeFlags("Additions", report.Additions)
+	writeFlags("Removals", report.Removals)
+
+	if len(report.Renames) > 0 {
+		fmt.Fprint(w, "\n### Renames\n\n")
+		fmt.Fprintln(w, "| Flag | Renamed from | Field | Before | After |")
+		fmt.Fprintln(w, "| --- | --- | --- | --- | --- |")
+		for _, rename := range report.Renames {
+			if len(rename.Fields) == 0 {
+				fmt.Fprintf(w, "| %s | %s | - | - | - |\n", markdownCode(rename.Key), markdownCode(rename.From))
+				continue
+			}
+			for i, fc := range rename.Fields {
+				flagCell, fromCell := "", ""
+				if i == 0 {
+					flagCell, fromCell = markdownCode(rename.Key), markdownCode(rename.From)
+				}
+				fmt.Fprintf(w, "| %s | %s | %s | %s | %s |\n", flagCell, fromCell, markdownText(fc.Field),
+					markdownCode(fc.OldValue), markdownCode(fc.NewValue))
+			}
+		}
+	}
+
+	if len(report.Modifications) > 0 {
+		fmt.Fprint(w, "\n### Modifications\n\n")
+		fmt.Fprintln(w, "| Flag | Severity | Field | Before | After |")
+		fmt.Fprintln(w, "| --- | --- | --- | --- | --- |")
+		for _, modification := range report.Modifications {
+			for i, fc := range modification.Fields {
+				// Only name the flag on its first row, so that the rows of a flag read as a group
+				flagCell, severityCell := "", ""
+				if i == 0 {
+					flagCell, severityCell = markdownCode(modification.Key), string(modification.Severity)
+				}
+				fmt.Fprintf(w, "| %s | %s | %s | %s | %s |\n", flagCell, severityCell, markdownText(fc.Field),
+					markdownCode(fc.OldValue), markdownCode(fc.NewValue))
+			}
+		}
+	}
+	return nil
+}
+
+// markdownText escapes text for a Markdown table cell
+func markdownText(text string) string {
+	text = strings.ReplaceAll(text, "|", `\|`)
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// markdownCode formats text as inline code for a Markdown table cell, or "-" when it is empty.
+// The code span is delimited by more backticks than the longest run of backticks in the text.
+func markdownCode(text string) string {
+	if text == "" {
+		return "-"
+	}
+
+	longest, run := 0, 0
+	for _, r := range text {
+		if r == '`' {
+			run++
+			longest = max(longest, run)
+		} else {
+			run = 0
+		}
+	}
+	fence := strings.Repeat("`", longest+1)
+
+	text = strings.ReplaceAll(text, "|", `\|`)
+	if strings.HasPrefix(text, "`") || strings.HasSuffix(text, "`") {
+		// Code spans strip one leading and trailing space, so the backticks are not read as part of the fence
+		text = " " + text + " "
+	}
+	return fence + text + fence
+}
+
+// renderHTMLDiff renders changes as HTML tables, e.g. for pull request comments
+func renderHTMLDiff(changes []manifest.Change, cmd *cobra.Command) error {
+	report := newDiffReport(changes)
+	w := cmd.OutOrStdout()
+
+	fmt.Fprintln(w, "<h2>Flag manifest changes</h2>")
+	if report.TotalChanges == 0 {
+		fmt.Fprintln(w, "<p>No differences found between the manifests.</p>")
+		return nil
+	}
+	fmt.Fprintf(w, "<p>Found %d difference(s) between manifests: %s.</p>\n", report.TotalChanges, report.Summary)
+
+	writeFlags := func(title string, flags []diffFlag) {
+		if len(flags) == 0 {
+			return
+		}
+		fmt.Fprintf(w, "<h3>%s</h3>\n<table>\n", title)
+		fmt.Fprintln(w, "<thead><tr><th>Flag</th><th>Type</th><th>Default value</th><th>Description</th></tr></thead>")
+		fmt.Fprintln(w, "<tbody>")
+		for _, flag := range flags {
+			fmt.Fprintf(w, "<tr><td>%s</td><td>%s</td><td>%s</td><td>%s</td></tr>\n", htmlCode(flag.Key),
+				html.EscapeString(flag.Type), htmlCode(flag.DefaultValue), html.EscapeString(flag.Description))
+		}
+		fmt.Fprintln(w, "</tbody>\n</table>")
+	}
+	writeFlags("Additions", report.Additions)
+	writeFlags("Removals", report.Removals)
+
+	if len(report.Renames) > 0 {
+		fmt.Fprintln(w, "<h3>Renames</h3>\n<table>")
+		fmt.Fprintln(w, "<thead><tr><th>Flag</th><th>Renamed from</th><th>Field</th><th>Before</th><th>After</th></tr></thead>")
+		fmt.Fprintln(w, "<tbody>")
+		for _, rename := range report.Renames {
+			if len(rename.Fields) == 0 {
+				fmt.Fprintf(w, "<tr><td>%s</td><td>%s</td><td>-</td><td>-</td><td>-</td></tr>\n", htmlCode(rename.Key), htmlCode(rename.From))
+				continue
+			}
+			for i, fc := range rename.Fields {
+				fmt.Fprint(w, "<tr>")
+				if i == 0 {
+					fmt.Fprintf(w, `<td rowspan="%d">%s</td><td rowspan="%d">%s</td>`, len(rename.Fields), htmlCode(rename.Key),
+						len(rename.Fields), htmlCode(rename.From))
+				}
+				fmt.Fprintf(w, "<td>%s</td><td>%s</td><td>%s</td></tr>\n", html.EscapeString(fc.Field),
+					htmlCode(fc.OldValue), htmlCode(fc.NewValue))
+			}
+		}
+		fmt.Fprintln(w, "</tbody>\n</table>")
+	}
+
+	if len(report.Modifications) > 0 {
+		fmt.Fprintln(w, "<h3>Modifications</h3>\n<table>")
+		fmt.Fprintln(w, "<thead><tr><th>Flag</th><th>Severity</th><th>Field</th><th>Before</th><th>After</th></tr></thead>")
+		fmt.Fprintln(w, "<tbody>")
+		for _, modification := range report.Modifications {
+			for i, fc := range modification.Fields {
+				fmt.Fprint(w, "<tr>")
+				if i == 0 {
+					fmt.Fprintf(w, `<td rowspan="%d">%s</td><td rowspan="%d">%s</td>`, len(modification.Fields), htmlCode(modification.Key),
+						len(modification.Fields), html.EscapeString(string(modification.Severity)))
+				}
+				fmt.Fprintf(w, "<td>%s</td><td>%s</td><td>%s</td></tr>\n", html.EscapeString(fc.Field),
+					htmlCode(fc.OldValue), htmlCode(fc.NewValue))
+			}
+		}
+		fmt.Fprintln(w, "</tbody>\n</table>")
+	}
+	return nil
+}
+
+// htmlCode formats text as escaped inline code for a table cell, or "-" when it is empty
+func htmlCode(text string) string {
+	if text == "" {
+		return "-"
+	}
+	return "<code>" + html.EscapeString(text) + "</code>"
+}
@@ -3,11 +3,16 @@ package cmd
 import (
 	"bytes"
 	"encoding/json"
+	"encoding/pem"
 	"fmt"
 	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/h2non/gock"
 	"github.com/open-feature/cli/internal/manifest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -42,7 +47,7 @@ func TestCompareManifests(t *testing.T) {
 	// This test mainly verifies the command executes without errors
 	// with each of the supported output formats
 
-	formats := []string{"tree", "flat", "json", "yaml"}
+	formats := []string{"tree", "flat", "json", "yaml", "markdown", "html"}
 
 	for _, format := range formats {
 		t.Run(fmt.Sprintf("output_format_%s", format), func(t *testing.T) {
@@ -263,3 +268,275 @@ func TestCompareWithReverseFlag(t *testing.T) {
 			"welcomeMessage should NOT be in removals")
 	})
 }
+
+func TestCompareMarkdownAndHTMLOutput(t *testing.T) {
+	runCompare := func(t *testing.T, format, against string) string {
+		return captureStdout(func() {
+			rootCmd := GetRootCmd()
+			rootCmd.SetArgs([]string{
+				"compare",
+				"--manifest", "testdata/source_manifest.json",
+				"--against", against,
+				"--output", format,
+			})
+
+			err := rootCmd.Execute()
+			assert.NoError(t, err)
+		})
+	}
+
+	t.Run("markdown", func(t *testing.T) {
+		output := runCompare(t, "markdown", "testdata/target_manifest.json")
+
+		assert.Contains(t, output, "## Flag manifest changes")
+		assert.Contains(t, output, "Found 4 difference(s) between manifests: 1 breaking, 2 potentially-breaking, 1 safe.")
+		assert.Contains(t, output, "### Additions\n\n| Flag | Type | Default value | Description |\n| --- | --- | --- | --- |\n"+
+			"| `maxItems` | integer | `10` | Maximum number of items to display |\n")
+		assert.Contains(t, output, "### Removals\n\n| Flag | Type | Default value | Description |\n| --- | --- | --- | --- |\n"+
+			"| `welcomeMessage` | string | `\"Hello, Welcome to OpenFeature!\"` | Welcome message to display |\n")
+		assert.Contains(t, output, "### Modifications\n\n| Flag | Severity | Field | Before | After |\n| --- | --- | --- | --- | --- |\n"+
+			"| `backgroundColor` | potentially-breaking | defaultValue | `\"black\"` | `\"white\"` |\n"+
+			"| `darkMode` | potentially-breaking | defaultValue | `true` | `false` |\n"+
+			"|  |  | description | `\"Enable dark mode for the application\"` | `\"Enable dark mode\"` |\n")
+	})
+
+	t.Run("html", func(t *testing.T) {
+		output := runCompare(t, "html", "testdata/target_manifest.json")
+
+		assert.Contains(t, output, "<h2>Flag manifest changes</h2>")
+		assert.Contains(t, output, "<tr><td><code>maxItems</code></td><td>integer</td><td><code>10</code></td><td>Maximum number of items to display</td></tr>")
+		assert.Contains(t, output, "<td><code>&#34;Hello, Welcome to OpenFeature!&#34;</code></td>")
+		assert.Contains(t, output, `<tr><td rowspan="2"><code>darkMode</code></td><td rowspan="2">potentially-breaking</td><td>defaultValue</td><td><code>true</code></td><td><code>false</code></td></tr>`)
+		assert.Contains(t, output, "<tr><td>description</td>")
+	})
+
+	t.Run("markdown with backticks in values", func(t *testing.T) {
+		dir := t.TempDir()
+		oldPath := filepath.Join(dir, "old.json")
+		newPath := filepath.Join(dir, "new.json")
+		require.NoError(t, os.WriteFile(oldPath, []byte(`{"flags": {"build": {"flagType": "string", "defaultValue": "run `+"`make`"+`"}}}`), 0o644))
+		require.NoError(t, os.WriteFile(newPath, []byte(`{"flags": {"build": {"flagType": "string", "defaultValue": "run `+"``make test``"+`"}}}`), 0o644))
+
+		output := captureStdout(func() {
+			rootCmd := GetRootCmd()
+			rootCmd.SetArgs([]string{"compare", "--manifest", newPath, "--against", oldPath, "--output", "markdown"})
+			assert.NoError(t, rootCmd.Execute())
+		})
+		assert.Contains(t, output, "| `build` | potentially-breaking | defaultValue | ``\"run `make`\"`` | ```\"run ``make test``\"``` |\n")
+	})
+
+	t.Run("no differences", func(t *testing.T) {
+		output := runCompare(t, "markdown", "testdata/source_manifest.json")
+		assert.Equal(t, "## Flag manifest changes\n\nNo differences found between the manifests.\n", output)
+
+		output = runCompare(t, "html", "testdata/source_manifest.json")
+		assert.Equal(t, "<h2>Flag manifest changes</h2>\n<p>No differences found between the manifests.</p>\n", output)
+	})
+}
+
+func TestCompareFailOn(t *testing.T) {
+	// A new default value is potentially breaking, but not breaking
+	dir := t.TempDir()
+	oldPath := filepath.Join(dir, "old.json")
+	newPath := filepath.Join(dir, "new.json")
+	require.NoError(t, os.WriteFile(oldPath, []byte(`{"flags": {"theme": {"flagType": "string", "defaultValue": "light"}}}`), 0o644))
+	require.NoError(t, os.WriteFile(newPath, []byte(`{"flags": {"theme": {"flagType": "string", "defaultValue": "dark"}}}`), 0o644))
+
+	runCompare := func(args ...string) error {
+		var err error
+		captureStdout(func() {
+			rootCmd := GetRootCmd()
+			rootCmd.SetArgs(append([]string{"compare", "--output", "json"}, args...))
+			err = rootCmd.Execute()
+		})
+		return err
+	}
+
+	t.Run("breaking changes fail", func(t *testing.T) {
+		// welcomeMessage only exists in the target, so it was removed
+		err := runCompare("--manifest", "testdata/source_manifest.json", "--against", "testdata/target_manifest.json", "--fail-on", "breaking")
+		assert.EqualError(t, err, "found 1 change(s) with severity breaking or higher")
+	})
+
+	t.Run("changes below the threshold pass", func(t *testing.T) {
+		err := runCompare("--manifest", newPath, "--against", oldPath, "--fail-on", "breaking")
+		assert.NoError(t, err)
+	})
+
+	t.Run("changes at the threshold fail", func(t *testing.T) {
+		err := runCompare("--manifest", newPath, "--against", oldPath, "--fail-on", "potentially-breaking")
+		assert.EqualError(t, err, "found 1 change(s) with severity potentially-breaking or higher")
+	})
+
+	t.Run("invalid severity", func(t *testing.T) {
+		err := runCompare("--manifest", newPath, "--against", oldPath, "--fail-on", "major")
+		assert.ErrorContains(t, err, "invalid --fail-on value: invalid severity: major")
+	})
+}
+
+func TestCompareRenames(t *testing.T) {
+	dir := t.TempDir()
+	oldPath := filepath.Join(dir, "old.json")
+	newPath := filepath.Join(dir, "new.json")
+	require.NoError(t, os.WriteFile(oldPath, []byte(`{"flags": {
+		"new-checkout": {"flagType": "boolean", "defaultValue": false, "description": "Enable the new checkout flow"},
+		"promo-banner": {"flagType": "string", "defaultValue": "", "description": "Text of the promotion banner"}
+	}}`), 0o644))
+	require.NoError(t, os.WriteFile(newPath, []byte(`{"flags": {
+		"checkout-v2": {"flagType": "boolean", "defaultValue": false, "description": "Enable the new checkout flow."},
+		"banner-text": {"flagType": "string", "defaultValue": "Sale", "renamedFrom": "promo-banner"}
+	}}`), 0o644))
+
+	runCompare := func(t *testing.T, format string) string {
+		return captureStdout(func() {
+			rootCmd := GetRootCmd()
+			rootCmd.SetArgs([]string{"compare", "--manifest", newPath, "--against", oldPath, "--output", format})
+			err := rootCmd.Execute()
+			assert.NoError(t, err)
+		})
+	}
+
+	t.Run("json", func(t *testing.T) {
+		var result struct {
+			TotalChanges int               `json:"totalChanges"`
+			Additions    []manifest.Change `json:"additions"`
+			Removals     []manifest.Change `json:"removals"`
+			Renames      []manifest.Change `json:"renames"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(runCompare(t, "json")), &result))
+
+		assert.Equal(t, 2, result.TotalChanges)
+		assert.Empty(t, result.Additions)
+		assert.Empty(t, result.Removals)
+		renames := make(map[string]string)
+		for _, change := range result.Renames {
+			renames[change.OldPath] = change.Path
+			assert.Equal(t, manifest.SeverityBreaking, change.Severity)
+		}
+		assert.Equal(t, map[string]string{
+			"flags.new-checkout": "flags.checkout-v2",
+			"flags.promo-banner": "flags.banner-text",
+		}, renames)
+	})
+
+	t.Run("markdown", func(t *testing.T) {
+		output := runCompare(t, "markdown")
+		assert.Contains(t, output, "### Renames\n\n| Flag | Renamed from | Field | Before | After |\n| --- | --- | --- | --- | --- |\n"+
+			"| `banner-text` | `promo-banner` | defaultValue | `\"\"` | `\"Sale\"` |\n"+
+			"|  |  | description | `\"Text of the promotion banner\"` | `(removed)` |\n"+
+			"| `checkout-v2` | `new-checkout` | description | `\"Enable the new checkout flow\"` | `\"Enable the new checkout flow.\"` |\n")
+		assert.NotContains(t, output, "renamedFrom")
+	})
+
+	t.Run("html", func(t *testing.T) {
+		output := runCompare(t, "html")
+		assert.Contains(t, output, "<h3>Renames</h3>")
+		assert.Contains(t, output, `<tr><td rowspan="1"><code>checkout-v2</code></td><td rowspan="1"><code>new-checkout</code></td><td>description</td>`)
+	})
+}
+
+func TestCompareExtendsRemoteWithCACert(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"flags": {"maintenance-mode": {"flagType": "boolean", "defaultValue": false}}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	dir := t.TempDir()
+	caPath := filepath.Join(dir, "ca.pem")
+	require.NoError(t, os.WriteFile(caPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0o600))
+	manifestPath := filepath.Join(dir, "flags.json")
+	require.NoError(t, os.WriteFile(manifestPath, []byte(`{"extends": ["`+server.URL+`/platform.json"], "flags": {}}`), 0o644))
+	againstPath := filepath.Join(dir, "against.json")
+	require.NoError(t, os.WriteFile(againstPath, []byte(`{"flags": {}}`), 0o644))
+
+	runCompare := func(args ...string) (string, error) {
+		var err error
+		output := captureStdout(func() {
+			rootCmd := GetRootCmd()
+			rootCmd.SetArgs(append([]string{"compare", "--manifest", manifestPath, "--against", againstPath, "--output", "json"}, args...))
+			err = rootCmd.Execute()
+		})
+		return output, err
+	}
+
+	_, err := runCompare()
+	assert.ErrorContains(t, err, "certificate")
+
+	output, err := runCompare("--ca-cert", caPath)
+	require.NoError(t, err)
+	assert.Contains(t, output, "flags.maintenance-mode")
+}
+
+func TestCompareAgainstRemote(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://flags.example.com").
+		Get("/openfeature/v0/manifest").
+		MatchHeader("Authorization", "Bearer remote-token").
+		Reply(200).
+		JSON(map[string]any{
+			"flags": []map[string]any{
+				{"key": "darkMode", "type": "boolean", "defaultValue": false, "description": "Enable dark mode"},
+				{"key": "backgroundColor", "type": "string", "defaultValue": "black", "description": "Background color for the application"},
+				{"key": "maxItems", "type": "integer", "defaultValue": 10, "description": "Maximum number of items to display"},
+			},
+		})
+
+	output := captureStdout(func() {
+		rootCmd := GetRootCmd()
+		rootCmd.SetArgs([]string{
+			"compare",
+			"--manifest", "testdata/source_manifest.json",
+			"--against", "https://flags.example.com",
+			"--auth-token", "remote-token",
+			"--output", "json",
+		})
+
+		assert.NoError(t, rootCmd.Execute())
+	})
+	assert.True(t, gock.IsDone())
+
+	var result struct {
+		TotalChanges  int               `json:"totalChanges"`
+		Modifications []manifest.Change `json:"modifications"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(output), &result))
+
+	// Flags that only differ in representation, like integer default values, are not reported
+	assert.Equal(t, 1, result.TotalChanges)
+	require.Len(t, result.Modifications, 1)
+	assert.Equal(t, "flags.backgroundColor", result.Modifications[0].Path)
+}
+
+func TestCompareAgainstUnreachableRemote(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://flags.example.com").
+		Get("/openfeature/v0/manifest").
+		Reply(500).
+		JSON(map[string]any{"error": "unavailable"})
+
+	rootCmd := GetRootCmd()
+	rootCmd.SetArgs([]string{
+		"compare",
+		"--manifest", "testdata/source_manifest.json",
+		"--against", "https://flags.example.com",
+	})
+
+	err := rootCmd.Execute()
+	assert.ErrorContains(t, err, "error loading target manifest")
+}
+
+func TestMarkdownCode(t *testing.T) {
+	tests := map[string]string{
+		"":             "-",
+		"true":         "`true`",
+		"a|b":          "`a\\|b`",
+		"run `make`":   "`` run `make` ``",
+		"``x`` and `y": "``` ``x`` and `y ```",
+		"`make`":       "`` `make` ``",
+	}
+	for text, expected := range tests {
+		assert.Equal(t, expected, markdownCode(text), text)
+	}
+}
@@ -2,31 +2,30 @@ package cmd
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/credentials"
 	"github.com/open-feature/cli/internal/logger"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 )
 
+// profilesConfigKey is the config file key holding the named provider profiles
+const profilesConfigKey = "profiles"
+
 // initializeConfig reads in config file and ENV variables if set.
 // It applies configuration values to command flags based on hierarchical priority.
 func initializeConfig(cmd *cobra.Command, bindPrefix string) error {
-	v := viper.New()
-
-	// Set the config file name and path
-	v.SetConfigName(".openfeature")
-	v.AddConfigPath(".")
-
 	logger.Default.Debug("Looking for .openfeature config file in current directory")
 
-	// Read the config file
-	if err := v.ReadInConfig(); err != nil {
-		// It's okay if there isn't a config file
-		if _, ok := err.(viper.ConfigFileNotFoundError); !ok {
-			return err
-		}
+	v, err := readConfigFile()
+	if err != nil {
+		return err
+	}
+	if v.ConfigFileUsed() == "" {
 		logger.Default.Debug("No config file found, using defaults and environment variables")
 	} else {
 		logger.Default.Debug(fmt.Sprintf("Using config file: %s", v.ConfigFileUsed()))
@@ -36,9 +35,14 @@ func initializeConfig(cmd *cobra.Command, bindPrefix string) error {
 	cmdLineFlags := make(map[string]bool)
 	cmd.Flags().Visit(func(f *pflag.Flag) {
 		cmdLineFlags[f.Name] = true
-		logger.Default.Debug(fmt.Sprintf("Flag set via command line: %s=%s", f.Name, f.Value.String()))
+		logger.Default.Debug(fmt.Sprintf("Flag set via command line: %s=%s", f.Name, flagValueForLog(f)))
 	})
 
+	profilePrefix, err := selectedProfilePrefix(cmd, v, bindPrefix, cmdLineFlags)
+	if err != nil {
+		return err
+	}
+
 	// Apply the configuration values
 	cmd.Flags().VisitAll(func(f *pflag.Flag) {
 		// Skip if flag was set on command line
@@ -47,23 +51,14 @@ func initializeConfig(cmd *cobra.Command, bindPrefix string) error {
 			return
 		}
 
-		// Build configuration paths from most specific to least specific
+		// Build configuration paths from most specific to least specific.
+		// The selected profile takes precedence over the rest of the config file
+		// (e.g., profiles.staging.push.provider-url, then profiles.staging.provider-url).
 		configPaths := []string{}
-
-		// Check the most specific path (e.g., generate.go.package-name)
-		if bindPrefix != "" {
-			configPaths = append(configPaths, bindPrefix+"."+f.Name)
-
-			// Check parent paths (e.g., generate.package-name)
-			parts := strings.Split(bindPrefix, ".")
-			for i := len(parts) - 1; i > 0; i-- {
-				parentPath := strings.Join(parts[:i], ".") + "." + f.Name
-				configPaths = append(configPaths, parentPath)
-			}
+		if profilePrefix != "" {
+			configPaths = append(configPaths, configPathsFor(profilePrefix, bindPrefix, f.Name)...)
 		}
-
-		// Check the base path (e.g., package-name)
-		configPaths = append(configPaths, f.Name)
+		configPaths = append(configPaths, configPathsFor("", bindPrefix, f.Name)...)
 
 		logger.Default.Debug(fmt.Sprintf("Looking for config value for flag %s in paths: %s", f.Name, strings.Join(configPaths, ", ")))
 
@@ -75,15 +70,108 @@ func initializeConfig(cmd *cobra.Command, bindPrefix string) error {
 				if err != nil {
 					logger.Default.Debug(fmt.Sprintf("Error setting flag %s from config: %v", f.Name, err))
 				} else {
-					logger.Default.Debug(fmt.Sprintf("Set flag %s=%s from config path %s", f.Name, val, path))
+					logger.Default.Debug(fmt.Sprintf("Set flag %s=%s from config path %s", f.Name, flagValueForLog(f), path))
 					break
 				}
 			}
 		}
 
 		// Log the final value for the flag
-		logger.Default.Debug(fmt.Sprintf("Final flag value: %s=%s", f.Name, f.Value.String()))
+		logger.Default.Debug(fmt.Sprintf("Final flag value: %s=%s", f.Name, flagValueForLog(f)))
 	})
 
 	return nil
 }
+
+// configPathsFor returns the config paths for a flag from most specific to least specific:
+// the command path (e.g., generate.go.package-name), its parent paths (e.g., generate.package-name)
+// and the base path (e.g., package-name), each under the given prefix
+func configPathsFor(prefix, bindPrefix, name string) []string {
+	if prefix != "" {
+		prefix += "."
+	}
+
+	configPaths := []string{}
+	if bindPrefix != "" {
+		configPaths = append(configPaths, prefix+bindPrefix+"."+name)
+
+		parts := strings.Split(bindPrefix, ".")
+		for i := len(parts) - 1; i > 0; i-- {
+			configPaths = append(configPaths, prefix+strings.Join(parts[:i], ".")+"."+name)
+		}
+	}
+	return append(configPaths, prefix+name)
+}
+
+// selectedProfilePrefix returns the config path of the profile selected with --profile, or
+// with a profile key in the config file, e.g. profiles.staging. It returns an empty string
+// when the command has no --profile flag or no profile is selected.
+func selectedProfilePrefix(cmd *cobra.Command, v *viper.Viper, bindPrefix string, cmdLineFlags map[string]bool) (string, error) {
+	profileFlag := cmd.Flags().Lookup(config.ProfileFlagName)
+	if profileFlag == nil {
+		return "", nil
+	}
+
+	profile := profileFlag.Value.String()
+	if !cmdLineFlags[config.ProfileFlagName] {
+		for _, path := range configPathsFor("", bindPrefix, config.ProfileFlagName) {
+			if v.IsSet(path) {
+				profile = v.GetString(path)
+				break
+			}
+		}
+	}
+	if profile == "" {
+		return "", nil
+	}
+
+	prefix := profilesConfigKey + "." + profile
+	if strings.Contains(profile, ".") || !v.IsSet(prefix) {
+		return "", fmt.Errorf("profile %q not found. Available profiles: %s", profile, availableProfiles(v))
+	}
+
+	if err := profileFlag.Value.Set(profile); err != nil {
+		return "", err
+	}
+	logger.Default.Debug(fmt.Sprintf("Using profile %s", profile))
+	return prefix, nil
+}
+
+// availableProfiles lists the profiles defined in the config file for error messages
+func availableProfiles(v *viper.Viper) string {
+	profiles := make([]string, 0)
+	for name := range v.GetStringMap(profilesConfigKey) {
+		profiles = append(profiles, name)
+	}
+	if len(profiles) == 0 {
+		return "none"
+	}
+	sort.Strings(profiles)
+	return strings.Join(profiles, ", ")
+}
+
+// readConfigFile reads the .openfeature config file in the current directory, if there is one
+func readConfigFile() (*viper.Viper, error) {
+	v := viper.New()
+
+	// Set the config file name and path
+	v.SetConfigName(".openfeature")
+	v.AddConfigPath(".")
+
+	// Read the config file
+	if err := v.ReadInConfig(); err != nil {
+		// It's okay if there isn't a config file
+		if _, ok := err.(viper.ConfigFileNotFoundError); !ok {
+			return nil, err
+		}
+	}
+	return v, nil
+}
+
+// flagValueForLog returns the value of a flag for debug output, redacting secrets
+func flagValueForLog(f *pflag.Flag) string {
+	if config.IsSecretFlag(f.Name) {
+		return credentials.Redact(f.Value.String())
+	}
+	return f.Value.String()
+}
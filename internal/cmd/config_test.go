@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/open-feature/cli/internal/config"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 )
@@ -131,3 +132,84 @@ generate:
 	assert.Equal(t, "output-from-cmdline", cmd.Flag("output").Value.String(),
 		"Command line value should override config file")
 }
+
+func TestFlagValueForLogRedactsSecrets(t *testing.T) {
+	cmd := setupTestCommand()
+	cmd.Flags().String("auth-token", "", "auth token")
+	assert.NoError(t, cmd.Flags().Set("auth-token", "secret-token"))
+	assert.NoError(t, cmd.Flags().Set("output", "out"))
+
+	assert.Equal(t, "<redacted>", flagValueForLog(cmd.Flag("auth-token")))
+	assert.Equal(t, "out", flagValueForLog(cmd.Flag("output")))
+}
+
+func TestProfileOverridesConfig(t *testing.T) {
+	setupConfigFileForTest(t, `
+manifest: flags.json
+push:
+  dry-run: true
+profiles:
+  staging:
+    provider-url: https://flags.staging.example.com
+    auth-token-file: /run/secrets/staging-token
+  prod:
+    provider-url: https://flags.example.com
+    auth-mode: oauth2
+    ca-cert: certs/prod-ca.pem
+    push:
+      manifest: prod-flags.json
+`)
+
+	t.Run("profile values take precedence over the rest of the config file", func(t *testing.T) {
+		cmd := GetPushCmd()
+		assert.NoError(t, cmd.ParseFlags([]string{"--profile", "prod"}))
+		assert.NoError(t, initializeConfig(cmd, "push"))
+
+		assert.Equal(t, "https://flags.example.com", cmd.Flag("provider-url").Value.String())
+		assert.Equal(t, "oauth2", cmd.Flag("auth-mode").Value.String())
+		assert.Equal(t, "certs/prod-ca.pem", cmd.Flag("ca-cert").Value.String())
+		assert.Equal(t, "prod-flags.json", cmd.Flag("manifest").Value.String())
+		assert.Equal(t, "true", cmd.Flag("dry-run").Value.String(), "settings missing from the profile come from the rest of the config file")
+	})
+
+	t.Run("command line overrides the profile", func(t *testing.T) {
+		cmd := GetPullCmd()
+		config.AddRootFlags(cmd)
+		assert.NoError(t, cmd.ParseFlags([]string{"--profile", "staging", "--provider-url", "https://localhost:8080"}))
+		assert.NoError(t, initializeConfig(cmd, "pull"))
+
+		assert.Equal(t, "https://localhost:8080", cmd.Flag("provider-url").Value.String())
+		assert.Equal(t, "/run/secrets/staging-token", cmd.Flag("auth-token-file").Value.String())
+		assert.Equal(t, "flags.json", cmd.Flag("manifest").Value.String())
+	})
+
+	t.Run("unknown profile returns error", func(t *testing.T) {
+		cmd := GetPullCmd()
+		config.AddRootFlags(cmd)
+		assert.NoError(t, cmd.ParseFlags([]string{"--profile", "qa"}))
+
+		err := initializeConfig(cmd, "pull")
+		assert.EqualError(t, err, `profile "qa" not found. Available profiles: prod, staging`)
+	})
+}
+
+func TestDefaultProfileFromConfig(t *testing.T) {
+	setupConfigFileForTest(t, `
+pull:
+  profile: dev
+profiles:
+  dev:
+    provider-url: http://localhost:8080
+`)
+
+	cmd := GetPullCmd()
+	config.AddRootFlags(cmd)
+	assert.NoError(t, initializeConfig(cmd, "pull"))
+	assert.Equal(t, "dev", cmd.Flag("profile").Value.String())
+	assert.Equal(t, "http://localhost:8080", cmd.Flag("provider-url").Value.String())
+
+	cmd = GetCompareCmd()
+	config.AddRootFlags(cmd)
+	assert.NoError(t, initializeConfig(cmd, "compare"))
+	assert.Equal(t, "", cmd.Flag("profile").Value.String(), "the default profile of pull does not apply to compare")
+}
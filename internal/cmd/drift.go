@@ -0,0 +1,315 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+// Exit codes of the drift command
+const (
+	DriftExitNone   = 0
+	DriftExitError  = 1
+	DriftExitLocal  = 2
+	DriftExitRemote = 3
+	DriftExitBoth   = 4
+)
+
+// driftReport is the result of a drift check, as rendered by every output format
+type driftReport struct {
+	Manifest string                 `json:"manifest"`
+	Provider string                 `json:"provider"`
+	Base     string                 `json:"base,omitempty"`
+	Status   string                 `json:"status"`
+	Local    int                    `json:"local"`
+	Remote   int                    `json:"remote"`
+	Both     int                    `json:"both"`
+	Flags    []manifest.DriftedFlag `json:"flags"`
+}
+
+func GetDriftCmd() *cobra.Command {
+	driftCmd := &cobra.Command{
+		Use:   "drift",
+		Short: "Detect drift between the local manifest and a provider",
+		Long: `Compare the local manifest with the flags of a provider and report the flags that
+differ, with the side they changed on.
+
+The side is determined against a base manifest, the last state both sides agreed on.
+By default this is the copy of the provider's manifest from the last pull or push; use --base
+to give another one, e.g. the manifest of the last release. Without a base, flags that
+exist on one side only are attributed to that side and other differences to both.
+
+The exit code tells the result apart, which makes the command suitable for scheduled
+CI jobs:
+  0  no drift
+  1  error
+  2  only the local manifest changed (push to update the provider)
+  3  only the provider changed (pull to update the manifest)
+  4  both changed`,
+		Example: `  # Check for drift
+  openfeature drift --provider-url https://api.example.com
+
+  # Write a Markdown report, e.g. for the body of an issue
+  openfeature drift --provider-url https://api.example.com --output markdown > drift.md
+
+  # Use the manifest of the last release as the base
+  openfeature drift --profile prod --base https://example.com/releases/latest/flags.json`,
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "drift")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			providerURL := config.GetFlagSourceURL(cmd)
+			manifestPath := config.GetManifestPath(cmd)
+			outputFormat, _ := cmd.Flags().GetString(config.OutputFlagName)
+			ignorePatterns, _ := cmd.Flags().GetStringArray(config.IgnoreFlagName)
+
+			if providerURL == "" {
+				return fmt.Errorf("provider URL is required. Please provide --provider-url")
+			}
+			if outputFormat != "table" && outputFormat != "json" && outputFormat != "markdown" {
+				return fmt.Errorf("invalid output format: %s. Valid formats are: table, json, markdown", outputFormat)
+			}
+
+			localManifest, err := manifest.LoadManifest(manifestPath, manifestLoadOptions(cmd))
+			if err != nil {
+				return fmt.Errorf("error loading manifest: %w", err)
+			}
+			localManifest, err = manifest.NormalizeManifest(localManifest)
+			if err != nil {
+				return fmt.Errorf("error loading manifest: %w", err)
+			}
+
+			backendOpts, err := resolveBackendOptions(cmd, providerURL)
+			if err != nil {
+				return err
+			}
+			remoteManifest, err := manifest.PullManifest(cmd.Context(), providerURL, backendOpts)
+			if err != nil {
+				return fmt.Errorf("error fetching flags from remote source: %w", err)
+			}
+
+			baseManifest, baseDescription, err := loadDriftBase(cmd, providerURL)
+			if err != nil {
+				return err
+			}
+
+			drifted, err := manifest.FindDrift(localManifest, remoteManifest, baseManifest, manifest.CompareOptions{
+				IgnorePatterns: ignorePatterns,
+				Filter:         tagFilterOptions(cmd),
+			})
+			if err != nil {
+				return fmt.Errorf("error comparing manifests: %w", err)
+			}
+
+			report := newDriftReport(manifestPath, providerURL, baseDescription, drifted)
+			switch outputFormat {
+			case "json":
+				err = renderDriftJSON(cmd.OutOrStdout(), report)
+			case "markdown":
+				renderDriftMarkdown(cmd.OutOrStdout(), report)
+			default:
+				displayDriftReport(report)
+			}
+			if err != nil {
+				return err
+			}
+
+			if code := report.exitCode(); code != DriftExitNone {
+				return &ExitError{Code: code}
+			}
+			return nil
+		},
+	}
+
+	// The exit code reports drift, so an ExitError must not print usage or an error message
+	driftCmd.SilenceErrors = true
+	driftCmd.SilenceUsage = true
+
+	config.AddDriftFlags(driftCmd)
+
+	return driftCmd
+}
+
+// loadDriftBase returns the base manifest given with --base, or else the copy from the last pull or push,
+// with a description for reports. The manifest is nil when there is no base.
+func loadDriftBase(cmd *cobra.Command, providerURL string) (*manifest.Manifest, string, error) {
+	if base := config.GetBase(cmd); base != "" {
+		var baseManifest *manifest.Manifest
+		var err error
+		if manifest.IsRemoteSource(base) {
+			var backendOpts manifest.BackendOptions
+			if backendOpts, err = resolveBackendOptions(cmd, base); err != nil {
+				return nil, "", err
+			}
+			baseManifest, err = manifest.PullManifest(cmd.Context(), base, backendOpts)
+		} else if baseManifest, err = manifest.LoadManifest(base, manifestLoadOptions(cmd)); err == nil {
+			baseManifest, err = manifest.NormalizeManifest(baseManifest)
+		}
+		if err != nil {
+			return nil, "", fmt.Errorf("error loading base manifest: %w", err)
+		}
+		return baseManifest, base, nil
+	}
+
+	cacheDir := config.GetCacheDir(cmd)
+	if cacheDir == "" {
+		cacheDir = manifest.DefaultCacheDir()
+	}
+	baseManifest, fetchedAt, err := manifest.NewCache(cacheDir).LastPulled(providerURL)
+	if err != nil {
+		return nil, "", fmt.Errorf("error loading base manifest: %w", err)
+	}
+	if baseManifest == nil {
+		return nil, "", nil
+	}
+	return baseManifest, fmt.Sprintf("copy synced at %s", fetchedAt.UTC().Format(time.RFC3339)), nil
+}
+
+func newDriftReport(manifestPath, providerURL, base string, drifted []manifest.DriftedFlag) *driftReport {
+	report := &driftReport{
+		Manifest: manifestPath,
+		Provider: providerURL,
+		Base:     base,
+		Flags:    drifted,
+	}
+	for _, flag := range drifted {
+		switch flag.Side {
+		case manifest.DriftSideLocal:
+			report.Local++
+		case manifest.DriftSideRemote:
+			report.Remote++
+		default:
+			report.Both++
+		}
+	}
+
+	switch report.exitCode() {
+	case DriftExitLocal:
+		report.Status = "local"
+	case DriftExitRemote:
+		report.Status = "remote"
+	case DriftExitBoth:
+		report.Status = "both"
+	default:
+		report.Status = "none"
+	}
+	return report
+}
+
+// exitCode returns the exit code for the drift in the report
+func (r *driftReport) exitCode() int {
+	switch {
+	case r.Both > 0 || (r.Local > 0 && r.Remote > 0):
+		return DriftExitBoth
+	case r.Local > 0:
+		return DriftExitLocal
+	case r.Remote > 0:
+		return DriftExitRemote
+	default:
+		return DriftExitNone
+	}
+}
+
+// displayDriftReport prints a table of the drifted flags
+func displayDriftReport(report *driftReport) {
+	if len(report.Flags) == 0 {
+		pterm.Success.Printfln("No drift between %s and %s", report.Manifest, report.Provider)
+		return
+	}
+
+	pterm.DefaultSection.Printfln("Drift between %s and %s", report.Manifest, report.Provider)
+	if report.Base == "" {
+		pterm.Warning.Println("No base manifest: pull first or use --base to tell which side changed a flag")
+	} else {
+		pterm.Info.Printfln("Base: %s", report.Base)
+	}
+
+	tableData := pterm.TableData{
+		{"Key", "Changed", "Local", "Remote"},
+	}
+	for _, flag := range report.Flags {
+		tableData = append(tableData, []string{flag.Key, driftSideLabel(flag.Side), driftValue(flag.Local), driftValue(flag.Remote)})
+	}
+	_ = pterm.DefaultTable.WithHasHeader().WithData(tableData).Render()
+
+	pterm.Warning.Println(driftSummary(report))
+}
+
+// renderDriftJSON prints the report as JSON
+func renderDriftJSON(w io.Writer, report *driftReport) error {
+	jsonBytes, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshaling JSON output: %w", err)
+	}
+
+	fmt.Fprintln(w, string(jsonBytes))
+	return nil
+}
+
+// renderDriftMarkdown prints the report as Markdown, e.g. for the body of an issue
+func renderDriftMarkdown(w io.Writer, report *driftReport) {
+	fmt.Fprintf(w, "## Flag drift between `%s` and `%s`\n\n", report.Manifest, report.Provider)
+	if len(report.Flags) == 0 {
+		fmt.Fprintln(w, "No drift.")
+		return
+	}
+
+	if report.Base == "" {
+		fmt.Fprintln(w, "No base manifest was available, so flags that differ on both sides may have changed on either one.")
+	} else {
+		fmt.Fprintf(w, "Base: %s\n", report.Base)
+	}
+	fmt.Fprintln(w)
+
+	fmt.Fprintln(w, "| Flag | Changed | Local | Remote |")
+	fmt.Fprintln(w, "| --- | --- | --- | --- |")
+	for _, flag := range report.Flags {
+		fmt.Fprintf(w, "| `%s` | %s | %s | %s |\n", flag.Key, driftSideLabel(flag.Side), markdownValue(flag.Local), markdownValue(flag.Remote))
+	}
+
+	fmt.Fprintf(w, "\n%s\n", driftSummary(report))
+}
+
+// driftSummary returns a one-line summary of the drift in the report
+func driftSummary(report *driftReport) string {
+	return fmt.Sprintf("%d flag(s) drifted: %d changed locally, %d changed remotely, %d changed on both sides",
+		len(report.Flags), report.Local, report.Remote, report.Both)
+}
+
+func driftSideLabel(side manifest.DriftSide) string {
+	switch side {
+	case manifest.DriftSideLocal:
+		return "locally"
+	case manifest.DriftSideRemote:
+		return "remotely"
+	default:
+		return "both sides"
+	}
+}
+
+// driftValue formats a flag for a table cell, or "-" when the flag does not exist on that side
+func driftValue(value any) string {
+	if value == nil {
+		return "-"
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
+
+// markdownValue formats a flag as inline code for a Markdown table cell
+func markdownValue(value any) string {
+	if value == nil {
+		return "-"
+	}
+	return markdownCode(driftValue(value))
+}
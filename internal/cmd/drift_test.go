@@ -0,0 +1,178 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/h2non/gock"
+	"github.com/open-feature/cli/internal/config"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const driftTestManifest = `{
+  "flags": {
+    "checkout": {"flagType": "boolean", "defaultValue": false, "description": "New checkout"},
+    "banner": {"flagType": "string", "defaultValue": "hello", "description": "Welcome banner"}
+  }
+}`
+
+// mockDriftProvider serves the given flags from the sync API of https://flags.example.com
+func mockDriftProvider(flags ...map[string]any) {
+	gock.New("https://flags.example.com").
+		Get("/openfeature/v0/manifest").
+		Reply(200).
+		JSON(map[string]any{"flags": flags})
+}
+
+func runDrift(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	cmd := GetDriftCmd()
+	config.AddRootFlags(cmd)
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetArgs(append([]string{"--provider-url", "https://flags.example.com", "--manifest", "flags.json", "--cache-dir", "cache"}, args...))
+	err := cmd.Execute()
+	return out.String(), err
+}
+
+func driftExitCode(err error) int {
+	if err == nil {
+		return DriftExitNone
+	}
+	if exitErr, ok := err.(*ExitError); ok {
+		return exitErr.Code
+	}
+	return DriftExitError
+}
+
+func TestDrift(t *testing.T) {
+	checkout := map[string]any{"key": "checkout", "type": "boolean", "defaultValue": false, "description": "New checkout"}
+	banner := map[string]any{"key": "banner", "type": "string", "defaultValue": "hello", "description": "Welcome banner"}
+	editedBanner := map[string]any{"key": "banner", "type": "string", "defaultValue": "hi there | welcome", "description": "Welcome banner"}
+
+	setup := func(t *testing.T) afero.Fs {
+		fs := setupTest(t)
+		t.Setenv("OPENFEATURE_AUTH_TOKEN", "")
+		require.NoError(t, afero.WriteFile(fs, "flags.json", []byte(driftTestManifest), 0o644))
+		return fs
+	}
+
+	t.Run("no drift", func(t *testing.T) {
+		setup(t)
+		defer gock.Off()
+		mockDriftProvider(checkout, banner)
+
+		_, err := runDrift(t)
+		assert.NoError(t, err)
+		assert.True(t, gock.IsDone())
+	})
+
+	t.Run("remote changes against a base manifest", func(t *testing.T) {
+		fs := setup(t)
+		defer gock.Off()
+		require.NoError(t, afero.WriteFile(fs, "base.json", []byte(driftTestManifest), 0o644))
+		mockDriftProvider(checkout, editedBanner)
+
+		out, err := runDrift(t, "--base", "base.json", "--output", "markdown")
+		assert.Equal(t, DriftExitRemote, driftExitCode(err))
+		assert.Contains(t, out, "## Flag drift between `flags.json` and `https://flags.example.com`")
+		assert.Contains(t, out, "Base: base.json")
+		assert.Contains(t, out, "| `banner` | remotely | ")
+		assert.Contains(t, out, `hi there \| welcome`, "pipes in values are escaped")
+		assert.Contains(t, out, "1 flag(s) drifted: 0 changed locally, 1 changed remotely, 0 changed on both sides")
+	})
+
+	t.Run("local changes against the copy from the last pull", func(t *testing.T) {
+		fs := setup(t)
+		defer gock.Off()
+
+		// Pulling caches the provider's manifest as the base
+		mockDriftProvider(checkout, banner)
+		pullCmd := GetPullCmd()
+		config.AddRootFlags(pullCmd)
+		pullCmd.SetArgs([]string{"--provider-url", "https://flags.example.com", "--manifest", "flags.json", "--cache-dir", "cache"})
+		require.NoError(t, pullCmd.Execute())
+
+		edited := `{"flags": {"checkout": {"flagType": "boolean", "defaultValue": true, "description": "New checkout"}, "banner": {"flagType": "string", "defaultValue": "hello", "description": "Welcome banner"}}}`
+		require.NoError(t, afero.WriteFile(fs, "flags.json", []byte(edited), 0o644))
+		mockDriftProvider(checkout, banner)
+
+		out, err := runDrift(t, "--output", "json")
+		assert.Equal(t, DriftExitLocal, driftExitCode(err))
+
+		var report driftReport
+		require.NoError(t, json.Unmarshal([]byte(out), &report))
+		assert.Equal(t, "local", report.Status)
+		assert.Contains(t, report.Base, "copy synced at ")
+		require.Len(t, report.Flags, 1)
+		assert.Equal(t, "checkout", report.Flags[0].Key)
+	})
+
+	t.Run("remote changes after a push", func(t *testing.T) {
+		fs := setup(t)
+		defer gock.Off()
+
+		mockDriftProvider(checkout, banner)
+		pullCmd := GetPullCmd()
+		config.AddRootFlags(pullCmd)
+		pullCmd.SetArgs([]string{"--provider-url", "https://flags.example.com", "--manifest", "flags.json", "--cache-dir", "cache"})
+		require.NoError(t, pullCmd.Execute())
+
+		// Pushing a local change replaces the pulled copy as the base
+		edited := `{"flags": {"checkout": {"flagType": "boolean", "defaultValue": true, "description": "New checkout"}, "banner": {"flagType": "string", "defaultValue": "hello", "description": "Welcome banner"}}}`
+		require.NoError(t, afero.WriteFile(fs, "flags.json", []byte(edited), 0o644))
+		mockDriftProvider(checkout, banner)
+		gock.New("https://flags.example.com").
+			Put("/openfeature/v0/manifest/flags/checkout").
+			Reply(200).
+			JSON(map[string]any{"flag": map[string]any{"key": "checkout"}, "updatedAt": "2024-03-02T09:45:03.000Z"})
+		pushCmd := GetPushCmd()
+		pushCmd.SetArgs([]string{"--provider-url", "https://flags.example.com", "--manifest", "flags.json", "--cache-dir", "cache"})
+		require.NoError(t, pushCmd.Execute())
+
+		pushedCheckout := map[string]any{"key": "checkout", "type": "boolean", "defaultValue": true, "description": "Checkout v2"}
+		mockDriftProvider(pushedCheckout, banner)
+
+		out, err := runDrift(t, "--output", "json")
+		assert.Equal(t, DriftExitRemote, driftExitCode(err))
+
+		var report driftReport
+		require.NoError(t, json.Unmarshal([]byte(out), &report))
+		assert.Equal(t, "remote", report.Status)
+		require.Len(t, report.Flags, 1)
+		assert.Equal(t, "checkout", report.Flags[0].Key)
+		assert.True(t, gock.IsDone())
+	})
+
+	t.Run("differences without a base are attributed to both sides", func(t *testing.T) {
+		setup(t)
+		defer gock.Off()
+		mockDriftProvider(checkout, editedBanner)
+
+		out, err := runDrift(t, "--output", "json")
+		assert.Equal(t, DriftExitBoth, driftExitCode(err))
+
+		var report driftReport
+		require.NoError(t, json.Unmarshal([]byte(out), &report))
+		assert.Equal(t, "both", report.Status)
+		assert.Empty(t, report.Base)
+	})
+
+	t.Run("errors exit with the error code", func(t *testing.T) {
+		setup(t)
+		defer gock.Off()
+
+		cmd := GetDriftCmd()
+		config.AddRootFlags(cmd)
+		cmd.SetArgs([]string{"--manifest", "flags.json"})
+		err := cmd.Execute()
+		assert.EqualError(t, err, "provider URL is required. Please provide --provider-url")
+		assert.Equal(t, DriftExitError, driftExitCode(err))
+
+		_, err = runDrift(t, "--output", "html")
+		assert.EqualError(t, err, "invalid output format: html. Valid formats are: table, json, markdown")
+	})
+}
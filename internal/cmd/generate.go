@@ -14,7 +14,6 @@ import (
 	"github.com/open-feature/cli/internal/generators/python"
 	"github.com/open-feature/cli/internal/generators/react"
 	"github.com/open-feature/cli/internal/logger"
-	"github.com/open-feature/cli/internal/manifest"
 	"github.com/spf13/cobra"
 )
 
@@ -90,7 +89,7 @@ func getGenerateNodeJSCmd() *cobra.Command {
 				TemplatePath: templatePath,
 				Custom:       nodejs.Params{},
 			}
-			flagset, err := manifest.LoadFlagSet(manifestPath)
+			flagset, err := loadFilteredFlagSet(cmd, manifestPath)
 			if err != nil {
 				return err
 			}
@@ -102,12 +101,22 @@ func getGenerateNodeJSCmd() *cobra.Command {
 				return err
 			}
 
+			if config.GetTestFixtures(cmd) {
+				logger.Default.Debug("Executing Node.js test fixtures generator")
+				if err := generator.GenerateTestFixtures(&params); err != nil {
+					return err
+				}
+			}
+
 			logger.Default.GenerationComplete("Node.js")
 
 			return nil
 		},
 	}
 
+	// Add Node.js-specific flags
+	config.AddNodeJSGenerateFlags(nodeJSCmd)
+
 	addStabilityInfo(nodeJSCmd)
 
 	return nodeJSCmd
@@ -136,7 +145,7 @@ func getGenerateReactCmd() *cobra.Command {
 				TemplatePath: templatePath,
 				Custom:       react.Params{},
 			}
-			flagset, err := manifest.LoadFlagSet(manifestPath)
+			flagset, err := loadFilteredFlagSet(cmd, manifestPath)
 			if err != nil {
 				return err
 			}
@@ -177,7 +186,7 @@ func GetGenerateNestJsCmd() *cobra.Command {
 
 			logger.Default.GenerationStarted("NestJS")
 
-			flagset, err := manifest.LoadFlagSet(manifestPath)
+			flagset, err := loadFilteredFlagSet(cmd, manifestPath)
 			if err != nil {
 				return err
 			}
@@ -241,7 +250,7 @@ func getGenerateCSharpCmd() *cobra.Command {
 					Namespace: namespace,
 				},
 			}
-			flagset, err := manifest.LoadFlagSet(manifestPath)
+			flagset, err := loadFilteredFlagSet(cmd, manifestPath)
 			if err != nil {
 				return err
 			}
@@ -294,7 +303,7 @@ func getGenerateJavaCmd() *cobra.Command {
 				},
 			}
 
-			flagset, err := manifest.LoadFlagSet(manifestPath)
+			flagset, err := loadFilteredFlagSet(cmd, manifestPath)
 			if err != nil {
 				return err
 			}
@@ -306,6 +315,13 @@ func getGenerateJavaCmd() *cobra.Command {
 				return err
 			}
 
+			if config.GetTestFixtures(cmd) {
+				logger.Default.Debug("Executing Java test fixtures generator")
+				if err := generator.GenerateTestFixtures(&params); err != nil {
+					return err
+				}
+			}
+
 			logger.Default.GenerationComplete("Java")
 
 			return nil
@@ -348,7 +364,7 @@ func getGenerateGoCmd() *cobra.Command {
 				},
 			}
 
-			flagset, err := manifest.LoadFlagSet(manifestPath)
+			flagset, err := loadFilteredFlagSet(cmd, manifestPath)
 			if err != nil {
 				return err
 			}
@@ -360,6 +376,13 @@ func getGenerateGoCmd() *cobra.Command {
 				return err
 			}
 
+			if config.GetTestFixtures(cmd) {
+				logger.Default.Debug("Executing Go test fixtures generator")
+				if err := generator.GenerateTestFixtures(&params); err != nil {
+					return err
+				}
+			}
+
 			logger.Default.GenerationComplete("Go")
 
 			return nil
@@ -394,7 +417,7 @@ func getGeneratePythonCmd() *cobra.Command {
 				TemplatePath: templatePath,
 				Custom:       python.Params{},
 			}
-			flagset, err := manifest.LoadFlagSet(manifestPath)
+			flagset, err := loadFilteredFlagSet(cmd, manifestPath)
 			if err != nil {
 				return err
 			}
@@ -440,7 +463,7 @@ func getGenerateAngularCmd() *cobra.Command {
 				TemplatePath: templatePath,
 				Custom:       angular.Params{},
 			}
-			flagset, err := manifest.LoadFlagSet(manifestPath)
+			flagset, err := loadFilteredFlagSet(cmd, manifestPath)
 			if err != nil {
 				return err
 			}
@@ -22,6 +22,7 @@ type generateTestCase struct {
 	outputFile     string // output file name
 	packageName    string // optional, used for Go (package-name), Java (package-name) and C# (namespace)
 	templateFile   string // optional, path to a custom template file
+	testFixtures   bool   // optional, also generate in-memory provider test fixtures
 }
 
 func TestGenerate(t *testing.T) {
@@ -85,6 +86,65 @@ func TestGenerate(t *testing.T) {
 			outputFile:     "OpenFeature.java",
 			packageName:    "com.example.openfeature",
 		},
+		{
+			name:           "Angular generation with deprecated flags",
+			command:        "angular",
+			manifestGolden: "testdata/deprecated_manifest.golden",
+			outputGolden:   "testdata/deprecated_angular.golden",
+			outputFile:     "openfeature.generated.ts",
+		},
+		{
+			name:           "Go generation with deprecated flags",
+			command:        "go",
+			manifestGolden: "testdata/deprecated_manifest.golden",
+			outputGolden:   "testdata/deprecated_go.golden",
+			outputFile:     "testpackage_gen.go",
+			packageName:    "testpackage",
+		},
+		{
+			name:           "React generation with deprecated flags",
+			command:        "react",
+			manifestGolden: "testdata/deprecated_manifest.golden",
+			outputGolden:   "testdata/deprecated_react.golden",
+			outputFile:     "openfeature.ts",
+		},
+		{
+			name:           "NodeJS generation with deprecated flags",
+			command:        "nodejs",
+			manifestGolden: "testdata/deprecated_manifest.golden",
+			outputGolden:   "testdata/deprecated_nodejs.golden",
+			outputFile:     "openfeature.ts",
+		},
+		{
+			name:           "NestJS generation with deprecated flags",
+			command:        "nestjs",
+			manifestGolden: "testdata/deprecated_manifest.golden",
+			outputGolden:   "testdata/deprecated_nestjs.golden",
+			outputFile:     "openfeature-decorators.ts",
+		},
+		{
+			name:           "Python generation with deprecated flags",
+			command:        "python",
+			manifestGolden: "testdata/deprecated_manifest.golden",
+			outputGolden:   "testdata/deprecated_python.golden",
+			outputFile:     "openfeature.py",
+		},
+		{
+			name:           "CSharp generation with deprecated flags",
+			command:        "csharp",
+			manifestGolden: "testdata/deprecated_manifest.golden",
+			outputGolden:   "testdata/deprecated_csharp.golden",
+			outputFile:     "OpenFeature.g.cs",
+			packageName:    "TestNamespace",
+		},
+		{
+			name:           "Java generation with deprecated flags",
+			command:        "java",
+			manifestGolden: "testdata/deprecated_manifest.golden",
+			outputGolden:   "testdata/deprecated_java.golden",
+			outputFile:     "OpenFeature.java",
+			packageName:    "com.example.openfeature",
+		},
 		{
 			name:           "Angular generation with custom template",
 			command:        "angular",
@@ -152,6 +212,32 @@ func TestGenerate(t *testing.T) {
 			outputFile:     "openfeature-decorators.ts",
 			templateFile:   "testdata/custom_template/custom_nestjs.tmpl",
 		},
+		{
+			name:           "Go test fixtures generation",
+			command:        "go",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/fixtures_go.golden",
+			outputFile:     "testpackage_fixtures_gen.go",
+			packageName:    "testpackage",
+			testFixtures:   true,
+		},
+		{
+			name:           "NodeJS test fixtures generation",
+			command:        "nodejs",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/fixtures_nodejs.golden",
+			outputFile:     "openfeature-fixtures.ts",
+			testFixtures:   true,
+		},
+		{
+			name:           "Java test fixtures generation",
+			command:        "java",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/fixtures_java.golden",
+			outputFile:     "OpenFeatureFixtures.java",
+			packageName:    "com.example.openfeature",
+			testFixtures:   true,
+		},
 		// Add more test cases here as needed
 	}
 
@@ -204,6 +290,10 @@ func TestGenerate(t *testing.T) {
 				args = append(args, "--template", memoryTemplatePath)
 			}
 
+			if tc.testFixtures {
+				args = append(args, "--test-fixtures")
+			}
+
 			cmd.SetArgs(args)
 
 			// Run command
@@ -139,7 +139,24 @@ const configTemplateText = `# OpenFeature CLI Configuration
 {{if .HasProviderURL}}provider: {{.ProviderURL}}{{else}}# provider: "https://your-flag-service.com/api/flags"{{end}}
 
 # Authentication token for remote flag providers (if required)
-# authToken: "your-bearer-token"
+# Prefer the OPENFEATURE_AUTH_TOKEN environment variable, an auth-token-file or a
+# credential helper, so the token does not end up in this file or in shell history.
+# auth-token-file: "/run/secrets/openfeature-token"
+
+# Credential helpers per provider host. Each command is run with the "get" argument
+# and prints the token as a "password=<token>" line, like a git credential helper.
+# credential-helpers:
+#   api.example.com: "/usr/local/bin/flags-credential-helper"
+
+# TLS and proxy settings per provider host, e.g. for services behind a private CA
+# that require client certificates. --ca-cert, --client-cert, --client-key and
+# --proxy take precedence.
+# hosts:
+#   flags.internal.example.com:
+#     ca-cert: "certs/internal-ca.pem"
+#     client-cert: "certs/openfeature-cli.pem"
+#     client-key: "certs/openfeature-cli-key.pem"
+#     proxy: "http://proxy.internal.example.com:3128"
 
 # Enable debug logging (default: false)
 # debug: false
@@ -147,6 +164,23 @@ const configTemplateText = `# OpenFeature CLI Configuration
 # Disable interactive prompts (default: false)
 # no-input: false
 
+# Named profiles, selected with --profile on pull, push and compare. Settings of
+# the selected profile take precedence over the rest of this file, and flags on
+# the command line take precedence over the profile. Set "profile" to select one
+# by default.
+# profiles:
+#   staging:
+#     provider-url: "https://flags.staging.example.com"
+#     auth-token-file: "/run/secrets/staging-token"
+#   prod:
+#     provider-url: "https://flags.example.com"
+#     auth-mode: "oauth2"
+#     oauth2-token-url: "https://auth.example.com/oauth/token"
+#     oauth2-client-id: "openfeature-cli"
+#     ca-cert: "certs/prod-ca.pem"
+#     push:
+#       dry-run: true
+
 # Command-Specific Configuration
 # Override global settings for specific commands
 
@@ -22,6 +22,9 @@ func GetManifestCmd() *cobra.Command {
 	manifestCmd.AddCommand(GetManifestAddCmd())
 	manifestCmd.AddCommand(GetManifestListCmd())
 	manifestCmd.AddCommand(GetManifestDeleteCmd())
+	manifestCmd.AddCommand(GetManifestStaleCmd())
+	manifestCmd.AddCommand(GetManifestImportCmd())
+	manifestCmd.AddCommand(GetManifestExportCmd())
 
 	addStabilityInfo(manifestCmd)
 
@@ -55,7 +55,10 @@ Examples:
   openfeature manifest add config --type object --default-value '{"key":"value"}'
   
   # Disable interactive prompts (for automation)
-  openfeature manifest add my-flag --default-value true --no-input`,
+  openfeature manifest add my-flag --default-value true --no-input
+
+  # Add a flag to a team's fragment of a manifest split across a directory
+  openfeature manifest add checkout-v2 --default-value false --manifest flags/ --fragment flags/payments/flags.json`,
 		Args: func(cmd *cobra.Command, args []string) error {
 			if len(args) > 1 {
 				return fmt.Errorf("too many arguments: expected 0 or 1 flag-key, got %d\n\nUsage: %s", len(args), cmd.Use)
@@ -141,15 +144,35 @@ Examples:
 				description = descInput
 			}
 
+			// When the manifest is composed of fragments, check the whole manifest for duplicates
+			// and write the new flag to the chosen fragment
+			targetPath := manifestPath
+			if manifest.IsComposite(manifestPath) {
+				targetPath, err = resolveAddFragment(manifestPath, config.GetFragment(cmd))
+				if err != nil {
+					return err
+				}
+
+				_, sources, err := manifest.LoadFlagSources(manifestPath, manifestLoadOptions(cmd))
+				if err != nil {
+					return fmt.Errorf("failed to load manifest: %w", err)
+				}
+				if source, ok := sources[flagName]; ok {
+					return fmt.Errorf("flag '%s' already exists in %s", flagName, source)
+				}
+			} else if config.GetFragment(cmd) != "" {
+				return fmt.Errorf("--fragment can only be used when --manifest is a directory or glob")
+			}
+
 			// Load existing manifest
 			var fs *flagset.Flagset
-			exists, err := afero.Exists(filesystem.FileSystem(), manifestPath)
+			exists, err := afero.Exists(filesystem.FileSystem(), targetPath)
 			if err != nil {
 				return fmt.Errorf("failed to check manifest existence: %w", err)
 			}
 
 			if exists {
-				fs, err = manifest.LoadFlagSet(manifestPath)
+				fs, err = manifest.LoadLocalFlagSet(targetPath)
 				if err != nil {
 					return fmt.Errorf("failed to load manifest: %w", err)
 				}
@@ -177,12 +200,12 @@ Examples:
 			fs.Flags = append(fs.Flags, newFlag)
 
 			// Write updated manifest
-			if err := manifest.Write(manifestPath, *fs); err != nil {
+			if err := manifest.WriteLocal(targetPath, *fs); err != nil {
 				return fmt.Errorf("failed to write manifest: %w", err)
 			}
 
 			// Success message
-			pterm.Success.Printfln("Flag '%s' added successfully to %s", flagName, manifestPath)
+			pterm.Success.Printfln("Flag '%s' added successfully to %s", flagName, targetPath)
 			logger.Default.Debug(fmt.Sprintf("Added flag: name=%s, type=%s, defaultValue=%v, description=%s",
 				flagName, flagType, defaultValue, description))
 
@@ -197,6 +220,26 @@ Examples:
 	return manifestAddCmd
 }
 
+// resolveAddFragment determines which fragment of a composed manifest a new flag is written to.
+// The fragment may be omitted when the manifest consists of a single file.
+func resolveAddFragment(manifestPath, fragment string) (string, error) {
+	if fragment == "" {
+		paths, err := manifest.ResolveManifestPaths(manifestPath)
+		if err != nil {
+			return "", err
+		}
+		if len(paths) != 1 {
+			return "", fmt.Errorf("%s contains %d manifest files; use --fragment to choose where to add the flag", manifestPath, len(paths))
+		}
+		return paths[0], nil
+	}
+
+	if !manifest.ContainsFragment(manifestPath, fragment) {
+		return "", fmt.Errorf("fragment %s is not part of the manifest %s", fragment, manifestPath)
+	}
+	return fragment, nil
+}
+
 // parseFlagTypeString converts a string flag type to FlagType enum
 func parseFlagTypeString(typeStr string) (flagset.FlagType, error) {
 	switch strings.ToLower(typeStr) {
@@ -607,3 +607,87 @@ func TestManifestAddCmd_NoFlagKeyArgument(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "flag-key argument is required when --no-input is set")
 }
+
+func TestManifestAddCmd_Fragments(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          []string
+		expectedError string
+		expectedFile  string
+	}{
+		{
+			name:         "add flag to chosen fragment",
+			args:         []string{"--fragment", "flags/search.json"},
+			expectedFile: "flags/search.json",
+		},
+		{
+			name:         "add flag to new fragment",
+			args:         []string{"--fragment", "flags/growth.json"},
+			expectedFile: "flags/growth.json",
+		},
+		{
+			name:          "fragment is required with multiple files",
+			expectedError: "use --fragment",
+		},
+		{
+			name:          "fragment outside of the manifest",
+			args:          []string{"--fragment", "other/flags.json"},
+			expectedError: "is not part of the manifest",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			filesystem.SetFileSystem(fs)
+			require.NoError(t, afero.WriteFile(fs, "flags/payments.json",
+				[]byte(`{"flags": {"checkout-v2": {"flagType": "boolean", "defaultValue": false}}}`), 0o644))
+			require.NoError(t, afero.WriteFile(fs, "flags/search.json",
+				[]byte(`{"flags": {"search-limit": {"flagType": "integer", "defaultValue": 10}}}`), 0o644))
+
+			cmd := GetManifestCmd()
+			config.AddRootFlags(cmd)
+			cmd.SetArgs(append([]string{
+				"add", "new-feature",
+				"--default-value", "true",
+				"--no-input",
+				"-m", "flags",
+			}, tt.args...))
+
+			err := cmd.Execute()
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+
+			content, err := afero.ReadFile(fs, tt.expectedFile)
+			require.NoError(t, err)
+			assert.Contains(t, string(content), "new-feature")
+		})
+	}
+}
+
+func TestManifestAddCmd_DuplicateAcrossFragments(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	filesystem.SetFileSystem(fs)
+	require.NoError(t, afero.WriteFile(fs, "flags/payments.json",
+		[]byte(`{"flags": {"checkout-v2": {"flagType": "boolean", "defaultValue": false}}}`), 0o644))
+	require.NoError(t, afero.WriteFile(fs, "flags/search.json",
+		[]byte(`{"flags": {"search-limit": {"flagType": "integer", "defaultValue": 10}}}`), 0o644))
+
+	cmd := GetManifestCmd()
+	config.AddRootFlags(cmd)
+	cmd.SetArgs([]string{
+		"add", "checkout-v2",
+		"--default-value", "true",
+		"--no-input",
+		"-m", "flags",
+		"--fragment", "flags/search.json",
+	})
+
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "already exists in flags/payments.json")
+}
@@ -34,6 +34,19 @@ Examples:
 			flagName := args[0]
 			manifestPath := config.GetManifestPath(cmd)
 
+			// When the manifest is composed of fragments, delete the flag from the fragment that defines it
+			if manifest.IsComposite(manifestPath) {
+				_, sources, err := manifest.LoadFlagSources(manifestPath, manifestLoadOptions(cmd))
+				if err != nil {
+					return fmt.Errorf("failed to load manifest: %w", err)
+				}
+				source, ok := sources[flagName]
+				if !ok {
+					return fmt.Errorf("flag '%s' not found in manifest", flagName)
+				}
+				manifestPath = source
+			}
+
 			// Check if manifest exists
 			exists, err := afero.Exists(filesystem.FileSystem(), manifestPath)
 			if err != nil {
@@ -45,7 +58,7 @@ Examples:
 			}
 
 			// Load existing manifest
-			fs, err := manifest.LoadFlagSet(manifestPath)
+			fs, err := manifest.LoadLocalFlagSet(manifestPath)
 			if err != nil {
 				return fmt.Errorf("failed to load manifest: %w", err)
 			}
@@ -62,7 +75,7 @@ Examples:
 			}
 
 			// Write updated manifest
-			if err := manifest.Write(manifestPath, *fs); err != nil {
+			if err := manifest.WriteLocal(manifestPath, *fs); err != nil {
 				return fmt.Errorf("failed to write manifest: %w", err)
 			}
 
@@ -460,3 +460,26 @@ func TestManifestDeleteCmd_DeleteLastFlag(t *testing.T) {
 	assert.Contains(t, flags, "bbb-second")
 	assert.NotContains(t, flags, "zzz-last")
 }
+
+func TestManifestDeleteCmd_FromFragment(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	filesystem.SetFileSystem(fs)
+	require.NoError(t, afero.WriteFile(fs, "flags/payments.json",
+		[]byte(`{"flags": {"checkout-v2": {"flagType": "boolean", "defaultValue": false}}}`), 0o644))
+	require.NoError(t, afero.WriteFile(fs, "flags/search.json",
+		[]byte(`{"flags": {"search-limit": {"flagType": "integer", "defaultValue": 10}}}`), 0o644))
+
+	cmd := GetManifestCmd()
+	config.AddRootFlags(cmd)
+	cmd.SetArgs([]string{"delete", "search-limit", "-m", "flags/*.json"})
+
+	require.NoError(t, cmd.Execute())
+
+	content, err := afero.ReadFile(fs, "flags/search.json")
+	require.NoError(t, err)
+	assert.NotContains(t, string(content), "search-limit")
+
+	content, err = afero.ReadFile(fs, "flags/payments.json")
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "checkout-v2")
+}
@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+func GetManifestExportCmd() *cobra.Command {
+	manifestExportCmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export the manifest to a provider-specific configuration",
+		Long: `Convert the flags in the manifest into a provider configuration that serves their default values.
+
+Supported formats:
+  - flagd: flagd JSON flag definitions. Each flag becomes an enabled flagd flag whose
+    default variant is the flag's default value. Boolean flags get "on" and "off" variants.
+
+The output can be converted back with 'openfeature manifest import --from flagd'.
+
+Examples:
+  # Print flagd flag definitions for the manifest
+  openfeature manifest export --format flagd
+
+  # Write the flag definitions to a file for flagd to load
+  openfeature manifest export --format flagd --output flagd/flags.json
+
+  # Only export flags tagged for the checkout team
+  openfeature manifest export --format flagd --include-tag checkout`,
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "manifest.export")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manifestPath := config.GetManifestPath(cmd)
+			outputPath := config.GetOutputPath(cmd)
+			format := config.GetExportFormat(cmd)
+
+			fs, err := loadFilteredFlagSet(cmd, manifestPath)
+			if err != nil {
+				return fmt.Errorf("failed to load manifest: %w", err)
+			}
+
+			data, err := manifest.Export(manifest.ExportFormat(format), fs)
+			if err != nil {
+				return err
+			}
+
+			if outputPath == "" {
+				fmt.Fprintln(cmd.OutOrStdout(), string(data))
+				return nil
+			}
+
+			if err := filesystem.WriteFile(outputPath, append(data, '\n')); err != nil {
+				return fmt.Errorf("failed to write %s: %w", outputPath, err)
+			}
+			pterm.Success.Printfln("Exported %d flag(s) to %s", len(fs.Flags), outputPath)
+			return nil
+		},
+	}
+
+	config.AddManifestExportFlags(manifestExportCmd)
+	addStabilityInfo(manifestExportCmd)
+
+	return manifestExportCmd
+}
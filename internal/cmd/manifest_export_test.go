@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManifestExportCmd(t *testing.T) {
+	manifestContent := `{
+		"$schema": "https://raw.githubusercontent.com/open-feature/cli/refs/heads/main/schema/v0/flag-manifest.json",
+		"flags": {
+			"show-banner": {
+				"flagType": "boolean",
+				"defaultValue": false,
+				"tags": ["marketing"]
+			},
+			"greeting": {
+				"flagType": "string",
+				"defaultValue": "Hello!"
+			}
+		}
+	}`
+
+	tests := []struct {
+		name          string
+		args          []string
+		expectedFile  string
+		expectedJSON  string
+		expectedError string
+	}{
+		{
+			name: "export to stdout",
+			args: []string{"export", "--format", "flagd"},
+			expectedJSON: `{
+				"$schema": "https://flagd.dev/schema/v0/flags.json",
+				"flags": {
+					"show-banner": {"state": "ENABLED", "variants": {"on": true, "off": false}, "defaultVariant": "off"},
+					"greeting": {"state": "ENABLED", "variants": {"default": "Hello!"}, "defaultVariant": "default"}
+				}
+			}`,
+		},
+		{
+			name:         "export tagged flags to a file",
+			args:         []string{"export", "--format", "flagd", "--include-tag", "marketing", "--output", "flagd/flags.json"},
+			expectedFile: "flagd/flags.json",
+			expectedJSON: `{
+				"$schema": "https://flagd.dev/schema/v0/flags.json",
+				"flags": {
+					"show-banner": {"state": "ENABLED", "variants": {"on": true, "off": false}, "defaultVariant": "off"}
+				}
+			}`,
+		},
+		{
+			name:          "unsupported format",
+			args:          []string{"export", "--format", "yaml"},
+			expectedError: "unsupported export format: yaml",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			filesystem.SetFileSystem(fs)
+			require.NoError(t, afero.WriteFile(fs, "flags.json", []byte(manifestContent), 0o644))
+
+			cmd := GetManifestCmd()
+			config.AddRootFlags(cmd)
+			out := &bytes.Buffer{}
+			cmd.SetOut(out)
+			cmd.SetArgs(append(tt.args, "-m", "flags.json"))
+
+			err := cmd.Execute()
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+
+			if tt.expectedFile == "" {
+				assert.JSONEq(t, tt.expectedJSON, out.String())
+				return
+			}
+			content, err := afero.ReadFile(fs, tt.expectedFile)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.expectedJSON, string(content))
+		})
+	}
+}
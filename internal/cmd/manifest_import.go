@@ -0,0 +1,135 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/pterm/pterm"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+func GetManifestImportCmd() *cobra.Command {
+	manifestImportCmd := &cobra.Command{
+		Use:   "import <file>",
+		Short: "Import flags from a provider-specific configuration",
+		Long: `Convert an existing provider configuration into flags in the manifest.
+
+Supported formats:
+  - flagd: flagd JSON flag definitions
+  - gofeatureflag: GO Feature Flag YAML configuration
+  - env: .env-style KEY=value boolean toggles
+
+The flag type is inferred from the variant values and the default variant becomes
+the flag's default value. Targeting rules and other provider-specific settings are
+not imported. Entries that cannot be converted are skipped with a warning.
+
+Imported flags are added to the manifest, which is created if it does not exist.
+Flags that already exist in the manifest cause an error unless --override is set.
+
+Examples:
+  # Import flagd flag definitions
+  openfeature manifest import --from flagd flags.flagd.json
+
+  # Import a GO Feature Flag configuration into a specific manifest
+  openfeature manifest import --from gofeatureflag flags.goff.yaml --manifest flags.json
+
+  # Import boolean toggles from a .env file, replacing existing flags
+  openfeature manifest import --from env .env --override`,
+		Args: cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "manifest.import")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sourcePath := args[0]
+			manifestPath := config.GetManifestPath(cmd)
+			format := config.GetImportFormat(cmd)
+			override := config.GetOverride(cmd)
+
+			if format == "" {
+				return fmt.Errorf("--from is required. Valid formats are: flagd, gofeatureflag, env")
+			}
+			if manifest.IsComposite(manifestPath) {
+				return fmt.Errorf("cannot import into %s: --manifest must be a single manifest file", manifestPath)
+			}
+
+			data, err := afero.ReadFile(filesystem.FileSystem(), sourcePath)
+			if err != nil {
+				return fmt.Errorf("failed to read %s: %w", sourcePath, err)
+			}
+
+			result, err := manifest.Import(manifest.ImportFormat(format), data)
+			if err != nil {
+				return err
+			}
+			for _, warning := range result.Warnings {
+				pterm.Warning.Printfln("Skipped %s", warning)
+			}
+			if len(result.Flagset.Flags) == 0 {
+				return fmt.Errorf("no flags found in %s", sourcePath)
+			}
+
+			// Load existing manifest
+			fs := &flagset.Flagset{Flags: []flagset.Flag{}}
+			exists, err := afero.Exists(filesystem.FileSystem(), manifestPath)
+			if err != nil {
+				return fmt.Errorf("failed to check manifest existence: %w", err)
+			}
+			if exists {
+				fs, err = manifest.LoadLocalFlagSet(manifestPath)
+				if err != nil {
+					return fmt.Errorf("failed to load manifest: %w", err)
+				}
+			}
+
+			if err := mergeImportedFlags(fs, result.Flagset.Flags, override); err != nil {
+				return err
+			}
+
+			if err := manifest.WriteLocal(manifestPath, *fs); err != nil {
+				return fmt.Errorf("failed to write manifest: %w", err)
+			}
+
+			pterm.Success.Printfln("Imported %d flag(s) from %s into %s", len(result.Flagset.Flags), sourcePath, manifestPath)
+			return nil
+		},
+	}
+
+	config.AddManifestImportFlags(manifestImportCmd)
+	addStabilityInfo(manifestImportCmd)
+
+	return manifestImportCmd
+}
+
+// mergeImportedFlags adds the imported flags to the flagset.
+// Existing flags are replaced when override is set; otherwise any conflict is an error.
+func mergeImportedFlags(fs *flagset.Flagset, imported []flagset.Flag, override bool) error {
+	existing := make(map[string]int, len(fs.Flags))
+	for i, flag := range fs.Flags {
+		existing[flag.Key] = i
+	}
+
+	var conflicts []string
+	for _, flag := range imported {
+		if i, ok := existing[flag.Key]; ok {
+			if !override {
+				conflicts = append(conflicts, flag.Key)
+				continue
+			}
+			fs.Flags[i] = flag
+			continue
+		}
+		fs.Flags = append(fs.Flags, flag)
+	}
+
+	if len(conflicts) > 0 {
+		sort.Strings(conflicts)
+		return fmt.Errorf("flags already exist in the manifest: %v. Use --override to replace them", conflicts)
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManifestImportCmd(t *testing.T) {
+	flagdConfig := `{
+		"flags": {
+			"show-banner": {
+				"state": "ENABLED",
+				"variants": {"on": true, "off": false},
+				"defaultVariant": "on"
+			},
+			"max-items": {
+				"state": "ENABLED",
+				"variants": {"small": 10, "large": 50},
+				"defaultVariant": "small"
+			}
+		}
+	}`
+	existingManifest := `{
+		"$schema": "https://raw.githubusercontent.com/open-feature/cli/refs/heads/main/schema/v0/flag-manifest.json",
+		"flags": {
+			"show-banner": {
+				"flagType": "boolean",
+				"defaultValue": false,
+				"description": "Existing banner flag"
+			},
+			"existing-flag": {
+				"flagType": "string",
+				"defaultValue": "keep me"
+			}
+		}
+	}`
+
+	tests := []struct {
+		name             string
+		args             []string
+		existingManifest string
+		expectedError    string
+		expectedDefaults map[string]any
+	}{
+		{
+			name: "import into a new manifest",
+			args: []string{"import", "flagd.json", "--from", "flagd"},
+			expectedDefaults: map[string]any{
+				"show-banner": true,
+				"max-items":   float64(10),
+			},
+		},
+		{
+			name:             "conflicting flags are rejected",
+			args:             []string{"import", "flagd.json", "--from", "flagd"},
+			existingManifest: existingManifest,
+			expectedError:    "flags already exist in the manifest: [show-banner]",
+		},
+		{
+			name:             "override replaces conflicting flags",
+			args:             []string{"import", "flagd.json", "--from", "flagd", "--override"},
+			existingManifest: existingManifest,
+			expectedDefaults: map[string]any{
+				"show-banner":   true,
+				"max-items":     float64(10),
+				"existing-flag": "keep me",
+			},
+		},
+		{
+			name:          "missing format",
+			args:          []string{"import", "flagd.json"},
+			expectedError: "--from is required",
+		},
+		{
+			name:          "missing source file",
+			args:          []string{"import", "missing.json", "--from", "flagd"},
+			expectedError: "failed to read missing.json",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			filesystem.SetFileSystem(fs)
+			require.NoError(t, afero.WriteFile(fs, "flagd.json", []byte(flagdConfig), 0o644))
+			if tt.existingManifest != "" {
+				require.NoError(t, afero.WriteFile(fs, "flags.json", []byte(tt.existingManifest), 0o644))
+			}
+
+			cmd := GetManifestCmd()
+			config.AddRootFlags(cmd)
+			cmd.SetArgs(append(tt.args, "-m", "flags.json"))
+
+			err := cmd.Execute()
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+
+			result, err := manifest.LoadFlagSet("flags.json", manifest.LoadOptions{})
+			require.NoError(t, err)
+
+			defaults := make(map[string]any)
+			for _, flag := range result.Flags {
+				defaults[flag.Key] = flag.DefaultValue
+			}
+			assert.Equal(t, tt.expectedDefaults, defaults)
+		})
+	}
+}
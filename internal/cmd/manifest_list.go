@@ -3,10 +3,11 @@ package cmd
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/open-feature/cli/internal/config"
 	"github.com/open-feature/cli/internal/flagset"
-	"github.com/open-feature/cli/internal/manifest"
 	"github.com/pterm/pterm"
 	"github.com/spf13/cobra"
 )
@@ -24,7 +25,7 @@ func GetManifestListCmd() *cobra.Command {
 			manifestPath := config.GetManifestPath(cmd)
 
 			// Load existing manifest
-			fs, err := manifest.LoadFlagSet(manifestPath)
+			fs, err := loadFilteredFlagSet(cmd, manifestPath)
 			if err != nil {
 				return fmt.Errorf("failed to load manifest: %w", err)
 			}
@@ -51,10 +52,26 @@ func displayFlagList(fs *flagset.Flagset, manifestPath string) {
 	// Print header
 	pterm.DefaultSection.Println(fmt.Sprintf("Flags in %s (%d)", manifestPath, len(fs.Flags)))
 
+	// Only show the name column when at least one flag has a display name
+	showNames := slices.ContainsFunc(fs.Flags, func(flag flagset.Flag) bool {
+		return flag.Name != ""
+	})
+
+	// Only show the tags column when at least one flag is tagged
+	showTags := slices.ContainsFunc(fs.Flags, func(flag flagset.Flag) bool {
+		return len(flag.Tags) > 0
+	})
+
 	// Create table data
-	tableData := pterm.TableData{
-		{"Key", "Type", "Default Value", "Description"},
+	header := []string{"Key"}
+	if showNames {
+		header = append(header, "Name")
+	}
+	header = append(header, "Type", "Default Value", "Description")
+	if showTags {
+		header = append(header, "Tags")
 	}
+	tableData := pterm.TableData{header}
 
 	for _, flag := range fs.Flags {
 		// Format default value for display
@@ -68,12 +85,15 @@ func displayFlagList(fs *flagset.Flagset, manifestPath string) {
 			description = description[:maxDescriptionLength-3] + "..."
 		}
 
-		tableData = append(tableData, []string{
-			flag.Key,
-			flag.Type.String(),
-			defaultValueStr,
-			description,
-		})
+		row := []string{flag.Key}
+		if showNames {
+			row = append(row, flag.Name)
+		}
+		row = append(row, flag.Type.String(), defaultValueStr, description)
+		if showTags {
+			row = append(row, strings.Join(flag.Tags, ", "))
+		}
+		tableData = append(tableData, row)
 	}
 
 	// Render table
@@ -17,6 +17,7 @@ func TestManifestListCmd(t *testing.T) {
 	tests := []struct {
 		name             string
 		manifestContent  string
+		args             []string
 		expectedError    string
 		expectedInOutput []string
 		notInOutput      []string
@@ -112,6 +113,91 @@ func TestManifestListCmd(t *testing.T) {
 				"(5)",
 			},
 		},
+		{
+			name: "list flags with display names",
+			manifestContent: `{
+				"flags": {
+					"search-rollout": {
+						"flagType": "boolean",
+						"name": "Search rollout",
+						"defaultValue": false
+					},
+					"batch-size": {
+						"flagType": "integer",
+						"defaultValue": 100
+					}
+				}
+			}`,
+			expectedInOutput: []string{
+				"Name",
+				"Search rollout",
+				"batch-size",
+			},
+		},
+		{
+			name: "list flags filtered by included tag",
+			manifestContent: `{
+				"flags": {
+					"checkout-v2": {
+						"flagType": "boolean",
+						"defaultValue": false,
+						"tags": ["web", "mobile"]
+					},
+					"push-notifications": {
+						"flagType": "boolean",
+						"defaultValue": true,
+						"tags": ["mobile"]
+					},
+					"batch-size": {
+						"flagType": "integer",
+						"defaultValue": 100,
+						"tags": ["backend"]
+					}
+				}
+			}`,
+			args: []string{"--include-tag", "mobile"},
+			expectedInOutput: []string{
+				"checkout-v2",
+				"push-notifications",
+				"Tags",
+				"web, mobile",
+				"(2)",
+			},
+			notInOutput: []string{
+				"batch-size",
+			},
+		},
+		{
+			name: "list flags filtered by excluded tag",
+			manifestContent: `{
+				"flags": {
+					"checkout-v2": {
+						"flagType": "boolean",
+						"defaultValue": false,
+						"tags": ["web", "mobile"]
+					},
+					"push-notifications": {
+						"flagType": "boolean",
+						"defaultValue": true,
+						"tags": ["mobile"]
+					},
+					"batch-size": {
+						"flagType": "integer",
+						"defaultValue": 100,
+						"tags": ["backend"]
+					}
+				}
+			}`,
+			args: []string{"--exclude-tag", "web,backend"},
+			expectedInOutput: []string{
+				"push-notifications",
+				"(1)",
+			},
+			notInOutput: []string{
+				"checkout-v2",
+				"batch-size",
+			},
+		},
 		{
 			name:          "error on missing manifest file",
 			expectedError: "failed to load manifest",
@@ -151,7 +237,7 @@ func TestManifestListCmd(t *testing.T) {
 			cmd := GetManifestCmd()
 			config.AddRootFlags(cmd)
 
-			cmd.SetArgs([]string{"list", "-m", "flags.json"})
+			cmd.SetArgs(append([]string{"list", "-m", "flags.json"}, tt.args...))
 
 			// Execute command
 			err := cmd.Execute()
@@ -0,0 +1,161 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+// unownedLabel is used to group flags that do not declare an owner
+const unownedLabel = "(unowned)"
+
+func GetManifestStaleCmd() *cobra.Command {
+	manifestStaleCmd := &cobra.Command{
+		Use:   "stale",
+		Short: "List expired or expiring flags",
+		Long: `List flags whose expiresAt date has passed or is coming up, grouped by owner.
+
+Flags without an expiresAt date are never reported. The command exits with a
+non-zero status when at least one temporary flag has expired, which makes it
+suitable for scheduled CI jobs. Flags with an expiry date but no lifecycle are
+treated as temporary.
+
+Examples:
+  # List flags that have expired or expire in the next 14 days
+  openfeature manifest stale
+
+  # Look further ahead
+  openfeature manifest stale --within-days 30
+
+  # Produce a machine-readable report
+  openfeature manifest stale --output json`,
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "manifest.stale")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manifestPath := config.GetManifestPath(cmd)
+			withinDays := config.GetWithinDays(cmd)
+			outputFormat, _ := cmd.Flags().GetString(config.OutputFlagName)
+
+			if outputFormat != "table" && outputFormat != "json" {
+				return fmt.Errorf("invalid output format: %s. Valid formats are: table, json", outputFormat)
+			}
+
+			fs, err := manifest.LoadFlagSet(manifestPath, manifestLoadOptions(cmd))
+			if err != nil {
+				return fmt.Errorf("failed to load manifest: %w", err)
+			}
+
+			staleFlags, err := manifest.FindStaleFlags(fs, time.Now(), withinDays)
+			if err != nil {
+				return err
+			}
+
+			if outputFormat == "json" {
+				if err := renderStaleJSON(cmd, staleFlags); err != nil {
+					return err
+				}
+			} else {
+				displayStaleFlags(staleFlags, manifestPath, withinDays)
+			}
+
+			expired := 0
+			for _, flag := range staleFlags {
+				if flag.IsExpiredTemporary() {
+					expired++
+				}
+			}
+			if expired > 0 {
+				return fmt.Errorf("%d temporary flag(s) have expired and should be removed", expired)
+			}
+
+			return nil
+		},
+	}
+
+	// Add command-specific flags
+	config.AddManifestStaleFlags(manifestStaleCmd)
+	addStabilityInfo(manifestStaleCmd)
+
+	return manifestStaleCmd
+}
+
+// groupStaleFlagsByOwner groups stale flags by owner, using a placeholder for flags without one
+func groupStaleFlagsByOwner(staleFlags []manifest.StaleFlag) (map[string][]manifest.StaleFlag, []string) {
+	grouped := make(map[string][]manifest.StaleFlag)
+	var owners []string
+	for _, flag := range staleFlags {
+		owner := flag.Owner
+		if owner == "" {
+			owner = unownedLabel
+		}
+		if _, ok := grouped[owner]; !ok {
+			owners = append(owners, owner)
+		}
+		grouped[owner] = append(grouped[owner], flag)
+	}
+	return grouped, owners
+}
+
+// displayStaleFlags prints a table of stale flags for each owner
+func displayStaleFlags(staleFlags []manifest.StaleFlag, manifestPath string, withinDays int) {
+	if len(staleFlags) == 0 {
+		pterm.Success.Printfln("No flags in %s have expired or expire within %d day(s)", manifestPath, withinDays)
+		return
+	}
+
+	grouped, owners := groupStaleFlagsByOwner(staleFlags)
+	for _, owner := range owners {
+		pterm.DefaultSection.Println(fmt.Sprintf("%s (%d)", owner, len(grouped[owner])))
+
+		tableData := pterm.TableData{
+			{"Key", "Lifecycle", "Expires", "Status"},
+		}
+		for _, flag := range grouped[owner] {
+			status := fmt.Sprintf("expires in %d day(s)", flag.DaysRemaining)
+			if flag.Status == manifest.StaleStatusExpired {
+				status = fmt.Sprintf("expired %d day(s) ago", -flag.DaysRemaining)
+			}
+			lifecycle := flag.Lifecycle
+			if lifecycle == "" {
+				lifecycle = "-"
+			}
+			tableData = append(tableData, []string{flag.Key, lifecycle, flag.ExpiresAt, status})
+		}
+
+		_ = pterm.DefaultTable.WithHasHeader().WithData(tableData).Render()
+	}
+}
+
+// renderStaleJSON prints the stale flags grouped by owner as JSON
+func renderStaleJSON(cmd *cobra.Command, staleFlags []manifest.StaleFlag) error {
+	type staleReport struct {
+		Expired  int                             `json:"expired"`
+		Expiring int                             `json:"expiring"`
+		Owners   map[string][]manifest.StaleFlag `json:"owners"`
+	}
+
+	grouped, _ := groupStaleFlagsByOwner(staleFlags)
+	report := staleReport{Owners: grouped}
+	for _, flag := range staleFlags {
+		if flag.Status == manifest.StaleStatusExpired {
+			report.Expired++
+		} else {
+			report.Expiring++
+		}
+	}
+
+	jsonBytes, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshaling JSON output: %w", err)
+	}
+
+	fmt.Fprintln(cmd.OutOrStdout(), string(jsonBytes))
+	return nil
+}
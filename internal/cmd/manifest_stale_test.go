@@ -0,0 +1,141 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// daysFromToday returns a YYYY-MM-DD date relative to today
+func daysFromToday(days int) string {
+	return time.Now().AddDate(0, 0, days).Format("2006-01-02")
+}
+
+func TestManifestStaleCmd(t *testing.T) {
+	tests := []struct {
+		name            string
+		manifestContent string
+		args            []string
+		expectedError   string
+		validateOutput  func(t *testing.T, output string)
+	}{
+		{
+			name: "no stale flags",
+			manifestContent: fmt.Sprintf(`{
+				"flags": {
+					"feature-a": {"flagType": "boolean", "defaultValue": true},
+					"feature-b": {"flagType": "boolean", "defaultValue": true, "expiresAt": %q}
+				}
+			}`, daysFromToday(60)),
+		},
+		{
+			name: "expiring flags do not fail",
+			manifestContent: fmt.Sprintf(`{
+				"flags": {
+					"feature-a": {"flagType": "boolean", "defaultValue": true, "owner": "team-a", "expiresAt": %q, "lifecycle": "temporary"}
+				}
+			}`, daysFromToday(3)),
+			args: []string{"--output", "json"},
+			validateOutput: func(t *testing.T, output string) {
+				var report map[string]any
+				require.NoError(t, json.Unmarshal([]byte(output), &report))
+				assert.Equal(t, float64(0), report["expired"])
+				assert.Equal(t, float64(1), report["expiring"])
+				owners := report["owners"].(map[string]any)
+				assert.Contains(t, owners, "team-a")
+			},
+		},
+		{
+			name: "expired temporary flag fails",
+			manifestContent: fmt.Sprintf(`{
+				"flags": {
+					"old-feature": {"flagType": "boolean", "defaultValue": true, "owner": "team-a", "expiresAt": %q, "lifecycle": "temporary"},
+					"kept-feature": {"flagType": "boolean", "defaultValue": true, "expiresAt": %q, "lifecycle": "permanent"}
+				}
+			}`, daysFromToday(-10), daysFromToday(-10)),
+			args:          []string{"--output", "json"},
+			expectedError: "1 temporary flag(s) have expired",
+			validateOutput: func(t *testing.T, output string) {
+				var report map[string]any
+				require.NoError(t, json.Unmarshal([]byte(output), &report))
+				assert.Equal(t, float64(2), report["expired"])
+				owners := report["owners"].(map[string]any)
+				assert.Contains(t, owners, "team-a")
+				assert.Contains(t, owners, unownedLabel)
+			},
+		},
+		{
+			name: "expired permanent flag does not fail",
+			manifestContent: fmt.Sprintf(`{
+				"flags": {
+					"kept-feature": {"flagType": "boolean", "defaultValue": true, "expiresAt": %q, "lifecycle": "permanent"}
+				}
+			}`, daysFromToday(-10)),
+		},
+		{
+			name: "within-days widens the window",
+			manifestContent: fmt.Sprintf(`{
+				"flags": {
+					"feature-a": {"flagType": "boolean", "defaultValue": true, "expiresAt": %q}
+				}
+			}`, daysFromToday(20)),
+			args: []string{"--within-days", "30", "--output", "json"},
+			validateOutput: func(t *testing.T, output string) {
+				var report map[string]any
+				require.NoError(t, json.Unmarshal([]byte(output), &report))
+				assert.Equal(t, float64(1), report["expiring"])
+			},
+		},
+		{
+			name:            "invalid output format",
+			manifestContent: `{"flags": {}}`,
+			args:            []string{"--output", "xml"},
+			expectedError:   "invalid output format",
+		},
+		{
+			name: "invalid lifecycle is rejected by schema",
+			manifestContent: `{
+				"flags": {
+					"feature-a": {"flagType": "boolean", "defaultValue": true, "lifecycle": "forever"}
+				}
+			}`,
+			expectedError: "failed to load manifest",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			filesystem.SetFileSystem(fs)
+			require.NoError(t, afero.WriteFile(fs, "flags.json", []byte(tt.manifestContent), 0o644))
+
+			cmd := GetManifestCmd()
+			config.AddRootFlags(cmd)
+
+			buf := &bytes.Buffer{}
+			cmd.SetOut(buf)
+			cmd.SetArgs(append([]string{"stale", "-m", "flags.json"}, tt.args...))
+
+			err := cmd.Execute()
+
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+
+			if tt.validateOutput != nil {
+				tt.validateOutput(t, buf.String())
+			}
+		})
+	}
+}
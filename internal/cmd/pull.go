@@ -2,8 +2,8 @@ package cmd
 
 import (
 	"fmt"
-	"net/url"
 	"strconv"
+	"time"
 
 	"github.com/open-feature/cli/internal/config"
 	"github.com/open-feature/cli/internal/flagset"
@@ -24,13 +24,28 @@ Supported URL schemes:
 - http:// - HTTP remote sources
 - https:// - HTTPS remote sources  
 - file:// - Local file paths
+- s3:// - Objects in S3-compatible buckets (s3://bucket/path/flags.json)
+- git+https://, git+ssh://, git+file:// - A manifest in a Git repository. Add ?ref= to select a branch,
+  tag or commit SHA and #path to read a file other than flags.json, e.g.
+  git+ssh://git@github.com/acme/platform.git?ref=v1.4.0#config/flags.json.
+  Only the commit at the ref is fetched, so pinning a tag or SHA gives reproducible pulls.
+- oci:// - Manifests stored as OCI artifacts (oci://registry/namespace/name:tag)
+
+S3 credentials, region and endpoint are read from the standard AWS environment
+variables (AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY, AWS_REGION). Set
+AWS_ENDPOINT_URL_S3 to use an S3-compatible service such as MinIO.
 
 How it works:
 1. Connects to the specified flag source URL
 2. Downloads the flag configuration data
 3. Validates and processes each flag definition
 4. Prompts for missing default values (unless --no-prompt is used)
-5. Writes the complete manifest to the local file system
+5. Writes the complete manifest to the local file system, unless it is already up to date
+
+Pulled manifests are cached per provider URL in the user cache directory (see --cache-dir).
+HTTP sources are sent conditional requests (If-None-Match / If-Modified-Since) so unchanged
+manifests are not downloaded again, and --offline uses the last pulled copy without
+contacting the provider, e.g. when the network is unavailable in CI.
 
 Why pull from a remote source:
 - Centralized flag management: Keep all flag definitions in a central repository or service
@@ -44,46 +59,45 @@ Why pull from a remote source:
 		RunE: func(cmd *cobra.Command, args []string) error {
 			providerURL := config.GetFlagSourceURL(cmd)
 			manifestPath := config.GetManifestPath(cmd)
-			authToken := config.GetAuthToken(cmd)
 			noPrompt := config.GetNoPrompt(cmd)
+			offline := config.GetOffline(cmd)
 
 			if providerURL == "" {
 				return fmt.Errorf("provider URL not set in config. Please provide --provider-url or set 'provider' in .openfeature.yaml")
 			}
 
-			// fetch the flags from the remote source
-			parsedURL, err := url.Parse(providerURL)
+			// The provider is not contacted offline, so there is no need to run a credential helper
+			var backendOpts manifest.BackendOptions
+			if !offline {
+				opts, err := resolveBackendOptions(cmd, providerURL)
+				if err != nil {
+					return err
+				}
+				backendOpts = opts
+			}
+
+			// fetch the flags from the backend selected by the URL scheme
+			backend, err := manifest.NewBackend(providerURL, backendOpts)
 			if err != nil {
-				return fmt.Errorf("invalid URL: %w", err)
+				return err
 			}
 
-			var flags *flagset.Flagset
-			switch parsedURL.Scheme {
-			case "file":
-				loadedFlags, err := manifest.LoadFromLocal(parsedURL.Path)
-				if err != nil {
-					return fmt.Errorf("error loading flags from local file: %w", err)
-				}
-				flags = loadedFlags
-			case "http", "https":
-				urlContainsAFileExtension := manifest.URLLooksLikeAFile(parsedURL.String())
-				if urlContainsAFileExtension {
-					// Use direct HTTP requests for pulling flags from file-like URLs
-					loadedFlags, err := manifest.LoadFromRemote(providerURL, authToken)
-					if err != nil {
-						return fmt.Errorf("error fetching flags from remote source: %w", err)
-					}
-					flags = loadedFlags
-				} else {
-					// Use the sync API client for pulling flags
-					loadedFlags, err := manifest.LoadFromSyncAPI(providerURL, authToken)
-					if err != nil {
-						return fmt.Errorf("error fetching flags from remote source: %w", err)
-					}
-					flags = loadedFlags
+			cacheDir := config.GetCacheDir(cmd)
+			if cacheDir == "" {
+				cacheDir = manifest.DefaultCacheDir()
+			}
+			cache := manifest.NewCache(cacheDir)
+
+			result, err := cache.Pull(cmd.Context(), providerURL, backend, offline)
+			if err != nil {
+				if fetchedAt, ok := cache.LastFetched(providerURL); ok && !offline {
+					return fmt.Errorf("error fetching flags from remote source: %w (use --offline to use the copy pulled at %s)", err, fetchedAt.Local().Format(time.RFC1123))
 				}
-			default:
-				return fmt.Errorf("unsupported URL scheme: %s. Supported schemes are file://, http://, and https://", parsedURL.Scheme)
+				return fmt.Errorf("error fetching flags from remote source: %w", err)
+			}
+			flags := result.Flags
+			if result.Offline {
+				pterm.Warning.Printfln("Offline: using the copy of %s pulled at %s, which may be out of date", providerURL, result.FetchedAt.Local().Format(time.RFC1123))
 			}
 
 			// Check each flag for null defaultValue
@@ -101,7 +115,13 @@ Why pull from a remote source:
 				}
 			}
 
-			pterm.Success.Printfln("Successfully fetched flags from %s", providerURL)
+			if !result.Offline {
+				pterm.Success.Printfln("Successfully fetched flags from %s", providerURL)
+			}
+			if manifest.IsUpToDate(manifestPath, *flags) {
+				pterm.Info.Printfln("Manifest %s is already up to date", manifestPath)
+				return nil
+			}
 			if err := manifest.Write(manifestPath, *flags); err != nil {
 				return fmt.Errorf("error writing manifest: %w", err)
 			}
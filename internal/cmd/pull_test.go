@@ -2,6 +2,11 @@ package cmd
 
 import (
 	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/h2non/gock"
@@ -400,4 +405,282 @@ func TestPull(t *testing.T) {
 		_, exists := flags["backwardCompatFlag"]
 		assert.True(t, exists, "Flag backwardCompatFlag should exist in manifest")
 	})
+
+	t.Run("pull caches the manifest and sends conditional requests", func(t *testing.T) {
+		fs := setupTest(t)
+		defer gock.Off()
+
+		gock.New("https://example.com").
+			Get("/openfeature/v0/manifest").
+			Reply(200).
+			SetHeader("ETag", `"v1"`).
+			JSON(map[string]any{
+				"flags": []map[string]any{
+					{"key": "cachedFlag", "type": "boolean", "defaultValue": true},
+				},
+			})
+		gock.New("https://example.com").
+			Get("/openfeature/v0/manifest").
+			MatchHeader("If-None-Match", `"v1"`).
+			Reply(304)
+
+		pull := func(extraArgs ...string) error {
+			cmd := GetPullCmd()
+			config.AddRootFlags(cmd)
+			cmd.SetArgs(append([]string{
+				"--provider-url", "https://example.com",
+				"--manifest", "manifest/path.json",
+				"--cache-dir", "cache",
+			}, extraArgs...))
+			return cmd.Execute()
+		}
+
+		assert.NoError(t, pull())
+		pulled, err := afero.ReadFile(fs, "manifest/path.json")
+		assert.NoError(t, err)
+		assert.Contains(t, string(pulled), "cachedFlag")
+		entries, err := afero.ReadDir(fs, "cache")
+		assert.NoError(t, err)
+		assert.Len(t, entries, 1)
+
+		// An unchanged manifest is served from the cache and not rewritten
+		stat, err := fs.Stat("manifest/path.json")
+		assert.NoError(t, err)
+		assert.NoError(t, pull())
+		assert.True(t, gock.IsDone(), "the second pull should send If-None-Match")
+		unchanged, err := fs.Stat("manifest/path.json")
+		assert.NoError(t, err)
+		assert.Equal(t, stat.ModTime(), unchanged.ModTime())
+
+		// Offline pulls use the cached copy without any request
+		assert.NoError(t, fs.Remove("manifest/path.json"))
+		assert.NoError(t, pull("--offline"))
+		offline, err := afero.ReadFile(fs, "manifest/path.json")
+		assert.NoError(t, err)
+		assert.Equal(t, string(pulled), string(offline))
+	})
+
+	t.Run("pull suggests --offline when the provider is unreachable", func(t *testing.T) {
+		setupTest(t)
+		defer gock.Off()
+
+		gock.New("https://example.com").
+			Get("/flags.json").
+			Reply(200).
+			JSON(map[string]any{
+				"flags": map[string]any{
+					"cachedFlag": map[string]any{"flagType": "boolean", "defaultValue": true},
+				},
+			})
+		gock.New("https://example.com").
+			Get("/flags.json").
+			Reply(503).
+			BodyString("unavailable")
+
+		pull := func(extraArgs ...string) error {
+			cmd := GetPullCmd()
+			config.AddRootFlags(cmd)
+			cmd.SetArgs(append([]string{
+				"--provider-url", "https://example.com/flags.json",
+				"--manifest", "manifest/path.json",
+				"--cache-dir", "cache",
+			}, extraArgs...))
+			return cmd.Execute()
+		}
+
+		assert.NoError(t, pull())
+		err := pull()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "received error response from flag source: unavailable")
+		assert.Contains(t, err.Error(), "use --offline to use the copy pulled at")
+	})
+
+	t.Run("pull --offline without a cached copy returns error", func(t *testing.T) {
+		setupTest(t)
+
+		cmd := GetPullCmd()
+		config.AddRootFlags(cmd)
+		cmd.SetArgs([]string{
+			"--provider-url", "https://example.com",
+			"--manifest", "manifest/path.json",
+			"--cache-dir", "cache",
+			"--offline",
+		})
+
+		err := cmd.Execute()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "no cached copy of https://example.com is available")
+	})
+
+	t.Run("pull reads the auth token from the environment", func(t *testing.T) {
+		setupTest(t)
+		defer gock.Off()
+		t.Setenv("OPENFEATURE_AUTH_TOKEN", "env-token")
+
+		gock.New("https://example.com").
+			Get("/openfeature/v0/manifest").
+			MatchHeader("Authorization", "Bearer env-token").
+			Reply(200).
+			JSON(map[string]any{"flags": []map[string]any{}})
+
+		cmd := GetPullCmd()
+		config.AddRootFlags(cmd)
+		cmd.SetArgs([]string{
+			"--provider-url", "https://example.com",
+			"--manifest", "manifest/path.json",
+		})
+
+		assert.NoError(t, cmd.Execute())
+		assert.True(t, gock.IsDone())
+	})
+
+	t.Run("pull uses the credential helper configured for the provider host", func(t *testing.T) {
+		setupTest(t)
+		defer gock.Off()
+		t.Setenv("OPENFEATURE_AUTH_TOKEN", "")
+		setupConfigFileForTest(t, `
+credential-helpers:
+  flags.example.com: "echo password=helper-token; true"
+  other.example.com: "exit 1; true"
+`)
+
+		gock.New("https://flags.example.com").
+			Get("/openfeature/v0/manifest").
+			MatchHeader("Authorization", "Bearer helper-token").
+			Reply(200).
+			JSON(map[string]any{"flags": []map[string]any{}})
+
+		cmd := GetPullCmd()
+		config.AddRootFlags(cmd)
+		cmd.SetArgs([]string{
+			"--provider-url", "https://flags.example.com",
+			"--manifest", "manifest/path.json",
+		})
+
+		assert.NoError(t, cmd.Execute())
+		assert.True(t, gock.IsDone())
+	})
+
+	t.Run("pull with oauth2 client credentials", func(t *testing.T) {
+		setupTest(t)
+		defer gock.Off()
+		t.Setenv("OPENFEATURE_OAUTH2_CLIENT_SECRET", "s3cret")
+
+		gock.New("https://auth.example.com").
+			Post("/oauth/token").
+			BodyString("grant_type=client_credentials&scope=flags%3Aread").
+			Reply(200).
+			JSON(map[string]any{"access_token": "access-token", "token_type": "Bearer", "expires_in": 3600})
+		gock.New("https://example.com").
+			Get("/openfeature/v0/manifest").
+			MatchHeader("Authorization", "Bearer access-token").
+			Reply(200).
+			JSON(map[string]any{"flags": []map[string]any{}})
+
+		cmd := GetPullCmd()
+		config.AddRootFlags(cmd)
+		cmd.SetArgs([]string{
+			"--provider-url", "https://example.com",
+			"--manifest", "manifest/path.json",
+			"--auth-mode", "oauth2",
+			"--oauth2-token-url", "https://auth.example.com/oauth/token",
+			"--oauth2-client-id", "cli",
+			"--oauth2-scopes", "flags:read",
+		})
+
+		assert.NoError(t, cmd.Execute())
+		assert.True(t, gock.IsDone())
+	})
+
+	t.Run("pull with incomplete oauth2 configuration returns error", func(t *testing.T) {
+		tests := []struct {
+			args          []string
+			expectedError string
+		}{
+			{[]string{"--auth-mode", "basic"}, "invalid auth mode: basic. Valid modes are: bearer, oauth2"},
+			{[]string{"--auth-mode", "oauth2"}, "OAuth2 token URL is required"},
+			{[]string{"--auth-mode", "oauth2", "--oauth2-token-url", "https://auth.example.com/token"}, "OAuth2 client ID is required"},
+			{[]string{"--auth-mode", "oauth2", "--oauth2-token-url", "https://auth.example.com/token", "--oauth2-client-id", "cli"}, "OAuth2 client secret is required"},
+		}
+
+		for _, tt := range tests {
+			setupTest(t)
+			t.Setenv("OPENFEATURE_OAUTH2_CLIENT_SECRET", "")
+
+			cmd := GetPullCmd()
+			config.AddRootFlags(cmd)
+			cmd.SetArgs(append([]string{"--provider-url", "https://example.com", "--manifest", "manifest/path.json"}, tt.args...))
+
+			err := cmd.Execute()
+			assert.ErrorContains(t, err, tt.expectedError)
+		}
+	})
+
+	t.Run("pull trusts the CA certificate configured for the provider host", func(t *testing.T) {
+		fs := setupTest(t)
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"flags": []}`))
+		}))
+		t.Cleanup(server.Close)
+		caCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+		assert.NoError(t, afero.WriteFile(fs, "certs/provider-ca.pem", caCert, 0o644))
+
+		cmd := GetPullCmd()
+		config.AddRootFlags(cmd)
+		cmd.SetArgs([]string{"--provider-url", server.URL, "--manifest", "manifest/path.json"})
+		assert.ErrorContains(t, cmd.Execute(), "certificate")
+
+		setupConfigFileForTest(t, fmt.Sprintf(`
+hosts:
+  %q:
+    ca-cert: certs/provider-ca.pem
+`, strings.TrimPrefix(server.URL, "https://")))
+
+		cmd = GetPullCmd()
+		config.AddRootFlags(cmd)
+		cmd.SetArgs([]string{"--provider-url", server.URL, "--manifest", "manifest/path.json"})
+		assert.NoError(t, cmd.Execute())
+	})
+
+	t.Run("pull with an incomplete client certificate returns error", func(t *testing.T) {
+		setupTest(t)
+
+		cmd := GetPullCmd()
+		config.AddRootFlags(cmd)
+		cmd.SetArgs([]string{
+			"--provider-url", "https://example.com",
+			"--manifest", "manifest/path.json",
+			"--client-cert", "certs/cli.pem",
+		})
+
+		err := cmd.Execute()
+		assert.ErrorContains(t, err, "invalid transport settings: a client certificate and a client key are both required for mutual TLS")
+	})
+
+	t.Run("pull from the provider of the selected profile", func(t *testing.T) {
+		setupTest(t)
+		defer gock.Off()
+		t.Setenv("OPENFEATURE_AUTH_TOKEN", "")
+		setupConfigFileForTest(t, `
+provider-url: https://flags.example.com
+profiles:
+  staging:
+    provider-url: https://flags.staging.example.com
+    credential-helper: "echo password=staging-token; true"
+`)
+
+		gock.New("https://flags.staging.example.com").
+			Get("/openfeature/v0/manifest").
+			MatchHeader("Authorization", "Bearer staging-token").
+			Reply(200).
+			JSON(map[string]any{"flags": []map[string]any{}})
+
+		cmd := GetPullCmd()
+		config.AddRootFlags(cmd)
+		cmd.SetArgs([]string{"--profile", "staging", "--manifest", "manifest/path.json"})
+
+		assert.NoError(t, cmd.Execute())
+		assert.True(t, gock.IsDone())
+	})
 }
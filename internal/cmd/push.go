@@ -4,9 +4,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"os/exec"
+	"path/filepath"
+	"strings"
 
 	"github.com/open-feature/cli/internal/api/sync"
 	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/open-feature/cli/internal/logger"
 	"github.com/open-feature/cli/internal/manifest"
 	"github.com/pterm/pterm"
 	"github.com/spf13/cobra"
@@ -40,6 +45,16 @@ The API uses individual flag endpoints:
 Remote services implementing this API should accept the flag data in the format
 specified by the OpenFeature flag manifest schema.
 
+The manifest can also be stored as a whole document in other locations:
+- s3://bucket/path/flags.json - Uploads the manifest to an S3-compatible bucket
+- git+https://, git+ssh://, git+file:// - Commits flags.json (or the file given by #path) to a Git repository
+  and pushes it (add ?ref= to select a branch)
+- oci://registry/namespace/name:tag - Pushes the manifest as an OCI artifact, annotated with the CLI
+  version, the flag count and the Git commit of the manifest
+
+These destinations replace the whole remote manifest, so --include-tag and --exclude-tag
+can only be used with the Manifest Management API.
+
 Note: The file:// scheme is not supported for push operations.
 For local file operations, use standard shell commands like cp or mv.`,
 		Example: `  # Push flags to a remote HTTPS endpoint (smart push: creates and updates as needed)
@@ -49,7 +64,13 @@ For local file operations, use standard shell commands like cp or mv.`,
   openfeature push --provider-url http://localhost:8080
 
   # Dry run to preview what would be sent
-  openfeature push --provider-url https://api.example.com --dry-run`,
+  openfeature push --provider-url https://api.example.com --dry-run
+
+  # Upload the manifest to a bucket
+  openfeature push --provider-url s3://platform-config/flags/flags.json
+
+  # Publish the manifest as a versioned OCI artifact
+  openfeature push --provider-url oci://ghcr.io/acme/flags:1.4.0 --auth-token $GHCR_TOKEN`,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			return initializeConfig(cmd, "push")
 		},
@@ -57,7 +78,6 @@ For local file operations, use standard shell commands like cp or mv.`,
 			// Get configuration values
 			providerURL := config.GetFlagSourceURL(cmd)
 			manifestPath := config.GetManifestPath(cmd)
-			authToken := config.GetAuthToken(cmd)
 			dryRun := config.GetDryRun(cmd)
 
 			// Validate destination URL is provided
@@ -71,8 +91,13 @@ For local file operations, use standard shell commands like cp or mv.`,
 				return fmt.Errorf("invalid source URL: %w", err)
 			}
 
+			backendOpts, err := resolveBackendOptions(cmd, providerURL)
+			if err != nil {
+				return err
+			}
+
 			// Load the local manifest
-			flags, err := manifest.LoadFlagSet(manifestPath)
+			flags, err := loadFilteredFlagSet(cmd, manifestPath)
 			if err != nil {
 				return fmt.Errorf("error loading manifest from %s: %w", manifestPath, err)
 			}
@@ -86,15 +111,39 @@ For local file operations, use standard shell commands like cp or mv.`,
 			case "http", "https":
 				// Perform smart push (fetches remote, compares, and creates/updates as needed)
 				// In dry run mode, performs comparison but skips actual API calls
-				result, err := manifest.SaveToRemote(providerURL, flags, authToken, dryRun)
+				result, err := manifest.SaveToRemote(providerURL, flags, backendOpts, dryRun)
 				if err != nil {
 					return fmt.Errorf("error pushing flags to remote destination: %w", err)
 				}
 
 				// Display the results
 				displayPushResults(result, providerURL, dryRun)
+				if !dryRun {
+					storePushedCopy(cmd, providerURL, result.Remote)
+				}
+			case "s3", "git+https", "git+ssh", "git+file", "oci":
+				// Pushing a filtered manifest would delete every other flag from the remote
+				if filter := tagFilterOptions(cmd); len(filter.IncludeTags) > 0 || len(filter.ExcludeTags) > 0 {
+					return fmt.Errorf("--include-tag and --exclude-tag cannot be used with %s:// destinations, which replace the whole remote manifest", parsedURL.Scheme)
+				}
+
+				backendOpts.Annotations = ociAnnotations(manifestPath)
+				backend, err := manifest.NewBackend(providerURL, backendOpts)
+				if err != nil {
+					return err
+				}
+
+				if dryRun {
+					pterm.Info.Printfln("DRY RUN: Would push %d flag(s) to %s", len(flags.Flags), providerURL)
+					return nil
+				}
+				if err := backend.Push(cmd.Context(), flags); err != nil {
+					return fmt.Errorf("error pushing flags to remote destination: %w", err)
+				}
+				pterm.Success.Printfln("Successfully pushed %d flag(s) to %s", len(flags.Flags), providerURL)
+				storePushedCopy(cmd, providerURL, flags)
 			default:
-				return fmt.Errorf("unsupported URL scheme: %s. Supported schemes are http:// and https://", parsedURL.Scheme)
+				return fmt.Errorf("unsupported URL scheme: %s. Supported schemes are http://, https://, s3://, git+https://, git+ssh://, git+file:// and oci://", parsedURL.Scheme)
 			}
 
 			return nil
@@ -110,6 +159,32 @@ For local file operations, use standard shell commands like cp or mv.`,
 	return pushCmd
 }
 
+// storePushedCopy replaces the cached copy of the provider's manifest with the flags it holds after a push,
+// so that drift does not compare against the copy from an earlier pull
+func storePushedCopy(cmd *cobra.Command, providerURL string, flags *flagset.Flagset) {
+	cacheDir := config.GetCacheDir(cmd)
+	if cacheDir == "" {
+		cacheDir = manifest.DefaultCacheDir()
+	}
+	if err := manifest.NewCache(cacheDir).Pushed(providerURL, flags); err != nil {
+		logger.Default.Debug(fmt.Sprintf("Failed to cache manifest pushed to %s: %v", providerURL, err))
+	}
+}
+
+// ociAnnotations returns the annotations recorded on pushed OCI artifacts: the CLI version
+// and, when the manifest is in a Git work tree, the commit it was pushed from
+func ociAnnotations(manifestPath string) map[string]string {
+	annotations := map[string]string{manifest.OCIAnnotationVersion: Version}
+
+	revision, err := exec.Command("git", "-C", filepath.Dir(manifestPath), "rev-parse", "HEAD").Output()
+	if err != nil {
+		logger.Default.Debug(fmt.Sprintf("Not recording a Git revision for %s: %v", manifestPath, err))
+		return annotations
+	}
+	annotations[manifest.OCIAnnotationRevision] = strings.TrimSpace(string(revision))
+	return annotations
+}
+
 // displayPushResults renders the push operation results with color-coded output
 // If dryRun is true, displays what would be pushed instead of what was pushed
 func displayPushResults(result *sync.PushResult, destination string, dryRun bool) {
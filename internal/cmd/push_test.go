@@ -10,6 +10,7 @@ import (
 	"github.com/open-feature/cli/internal/filesystem"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func setupPushTest(t *testing.T) afero.Fs {
@@ -318,7 +319,24 @@ func TestPush(t *testing.T) {
 		err := cmd.Execute()
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "unsupported URL scheme: ftp")
-		assert.Contains(t, err.Error(), "Supported schemes are http:// and https://")
+		assert.Contains(t, err.Error(), "Supported schemes are http://, https://, s3://, git+https://, git+ssh://, git+file:// and oci://")
+	})
+
+	t.Run("push with tag filters to a whole-document destination returns error", func(t *testing.T) {
+		setupPushTest(t)
+
+		cmd := GetPushCmd()
+
+		args := []string{
+			"--provider-url", "s3://platform-config/flags/flags.json",
+			"--manifest", "flags.json",
+			"--include-tag", "team-a",
+		}
+		cmd.SetArgs(args)
+
+		err := cmd.Execute()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "--include-tag and --exclude-tag cannot be used with s3:// destinations")
 	})
 
 	t.Run("error when fetch returns 404", func(t *testing.T) {
@@ -427,6 +445,50 @@ func TestPush(t *testing.T) {
 		assert.Contains(t, err.Error(), "500")
 	})
 
+	t.Run("push keeps names set at the remote", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		filesystem.SetFileSystem(fs)
+		require.NoError(t, afero.WriteFile(fs, "flags.json", []byte(`{"flags": {
+			"checkout": {"flagType": "boolean", "defaultValue": false, "description": "New checkout"},
+			"search": {"flagType": "boolean", "defaultValue": true, "description": "New search"}
+		}}`), 0o644))
+		defer gock.Off()
+
+		// Both flags have a display name set in the vendor UI, and only search changed locally
+		gock.New("https://api.example.com").
+			Get("/openfeature/v0/manifest").
+			Reply(200).
+			JSON(map[string]any{
+				"flags": []map[string]any{
+					{"key": "checkout", "name": "Checkout", "type": "boolean", "defaultValue": false, "description": "New checkout"},
+					{"key": "search", "name": "Search", "type": "boolean", "defaultValue": false, "description": "New search"},
+				},
+			})
+
+		var updated map[string]any
+		gock.New("https://api.example.com").
+			Put("/openfeature/v0/manifest/flags/search").
+			AddMatcher(func(req *http.Request, ereq *gock.Request) (bool, error) {
+				return true, json.NewDecoder(req.Body).Decode(&updated)
+			}).
+			Reply(200).
+			JSON(map[string]any{
+				"flag":      map[string]any{"key": "search"},
+				"updatedAt": "2024-03-02T09:45:03.000Z",
+			})
+
+		cmd := GetPushCmd()
+		cmd.SetArgs([]string{
+			"--provider-url", "https://api.example.com/openfeature/v0/manifest",
+			"--manifest", "flags.json",
+		})
+
+		require.NoError(t, cmd.Execute())
+		assert.True(t, gock.IsDone(), "Only the changed flag should be updated")
+		assert.Equal(t, true, updated["defaultValue"])
+		assert.NotContains(t, updated, "name", "An unmanaged name must not be sent")
+	})
+
 	t.Run("push validates request body format", func(t *testing.T) {
 		setupPushTest(t)
 		defer gock.Off()
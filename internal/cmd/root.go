@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"os"
 
@@ -22,11 +23,36 @@ func Execute(version string, commit string, date string) {
 	Commit = commit
 	Date = date
 	if err := GetRootCmd().Execute(); err != nil {
+		var exitErr *ExitError
+		if errors.As(err, &exitErr) {
+			if exitErr.Err != nil {
+				logger.Default.Error(exitErr.Err.Error())
+			}
+			os.Exit(exitErr.Code)
+		}
 		logger.Default.Error(err.Error())
 		os.Exit(1)
 	}
 }
 
+// ExitError is returned by commands that report their result through a specific exit code.
+// Err is logged before exiting, unless it is nil because the command already printed its result.
+type ExitError struct {
+	Code int
+	Err  error
+}
+
+func (e *ExitError) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("exit status %d", e.Code)
+	}
+	return e.Err.Error()
+}
+
+func (e *ExitError) Unwrap() error {
+	return e.Err
+}
+
 func GetRootCmd() *cobra.Command {
 	// Execute all parent's persistent hooks
 	cobra.EnableTraverseRunHooks = true
@@ -62,9 +88,12 @@ func GetRootCmd() *cobra.Command {
 	rootCmd.AddCommand(GetInitCmd())
 	rootCmd.AddCommand(GetGenerateCmd())
 	rootCmd.AddCommand(GetCompareCmd())
+	rootCmd.AddCommand(GetDriftCmd())
 	rootCmd.AddCommand(GetPullCmd())
 	rootCmd.AddCommand(GetPushCmd())
 	rootCmd.AddCommand(GetManifestCmd())
+	rootCmd.AddCommand(GetServeCmd())
+	rootCmd.AddCommand(GetAPICmd())
 
 	// Add a custom error handler after the command is created
 	rootCmd.SetFlagErrorFunc(func(cmd *cobra.Command, err error) error {
@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/open-feature/cli/internal/api/mock"
+	"github.com/open-feature/cli/internal/config"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+// serveShutdownTimeout bounds how long in-flight requests may take once the server is stopped
+const serveShutdownTimeout = 5 * time.Second
+
+func GetServeCmd() *cobra.Command {
+	serveCmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Serve the manifest through a local Manifest Management API",
+		Long: `Start a local HTTP server that implements the Manifest Management API
+defined in api/v0/sync.yaml, backed by the flag manifest.
+
+The server is a stand-in for a flag management service, so the push and pull
+workflow can be exercised offline and in CI. It supports:
+
+- GET /openfeature/v0/manifest - Returns the active flags
+- POST /openfeature/v0/manifest/flags - Creates a flag
+- PUT /openfeature/v0/manifest/flags/{key} - Updates a flag
+- DELETE /openfeature/v0/manifest/flags/{key} - Archives a flag
+
+Created and updated flags are written back to the manifest. Archived flags are removed
+from the manifest and kept in memory until the server stops; creating or updating an
+archived key returns 409 Conflict.
+
+When --auth-token is set, requests must send it as a bearer token. Use --capabilities
+to restrict what clients may do and test how tools handle 403 responses.`,
+		Example: `  # Serve flags.json on localhost:8080
+  openfeature serve
+
+  # Require a token and only allow reads
+  openfeature serve --auth-token secret-token --capabilities read
+
+  # Push to the local server
+  openfeature push --provider-url http://localhost:8080 --auth-token secret-token`,
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "serve")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manifestPath := config.GetManifestPath(cmd)
+			address := config.GetAddress(cmd)
+
+			var capabilities []mock.Capability
+			for _, capability := range config.GetCapabilities(cmd) {
+				capabilities = append(capabilities, mock.Capability(capability))
+			}
+
+			server, err := mock.NewServer(manifestPath, mock.Options{
+				AuthToken:    config.GetAuthToken(cmd),
+				Capabilities: capabilities,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to start server: %w", err)
+			}
+
+			listener, err := net.Listen("tcp", address)
+			if err != nil {
+				return fmt.Errorf("failed to listen on %s: %w", address, err)
+			}
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt)
+			defer stop()
+
+			httpServer := &http.Server{
+				Handler:           server,
+				ReadHeaderTimeout: 10 * time.Second,
+			}
+			go func() {
+				<-ctx.Done()
+				shutdownCtx, cancel := context.WithTimeout(context.Background(), serveShutdownTimeout)
+				defer cancel()
+				_ = httpServer.Shutdown(shutdownCtx)
+			}()
+
+			pterm.Info.Printfln("Serving %s on http://%s (press Ctrl+C to stop)", manifestPath, listener.Addr())
+			if err := httpServer.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				return fmt.Errorf("server error: %w", err)
+			}
+
+			pterm.Info.Println("Server stopped")
+			return nil
+		},
+	}
+
+	config.AddServeFlags(serveCmd)
+
+	return serveCmd
+}
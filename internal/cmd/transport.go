@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/open-feature/cli/internal/api/sync"
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/logger"
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/open-feature/cli/internal/transport"
+	"github.com/spf13/cobra"
+)
+
+// hostsConfigKey is the config file key holding the TLS and proxy settings of each provider host
+const hostsConfigKey = "hosts"
+
+// resolveTransport returns the TLS and proxy settings for requests to the URL. Settings given
+// with --ca-cert, --client-cert, --client-key and --proxy take precedence over the ones
+// configured for the host of the URL under hosts in the config file.
+func resolveTransport(cmd *cobra.Command, rawURL string) (transport.Options, error) {
+	opts := transport.Options{
+		CACert:     config.GetCACert(cmd),
+		ClientCert: config.GetClientCert(cmd),
+		ClientKey:  config.GetClientKey(cmd),
+		Proxy:      config.GetProxy(cmd),
+	}
+
+	hostOpts, err := configuredTransport(rawURL)
+	if err != nil {
+		return transport.Options{}, err
+	}
+	return opts.Merge(hostOpts), nil
+}
+
+// manifestLoadOptions returns the options for loading manifests, which fetch remote extended manifests
+// with the TLS and proxy settings for their host
+func manifestLoadOptions(cmd *cobra.Command) manifest.LoadOptions {
+	return manifest.LoadOptions{
+		HTTPClient: func(rawURL string) (*http.Client, error) {
+			opts, err := resolveTransport(cmd, rawURL)
+			if err != nil {
+				return nil, err
+			}
+			return transport.NewClient(opts, sync.DefaultTimeout)
+		},
+	}
+}
+
+// configuredTransport returns the transport settings configured for the host of the URL, e.g.
+//
+//	hosts:
+//	  flags.internal.example.com:
+//	    ca-cert: certs/internal-ca.pem
+func configuredTransport(rawURL string) (transport.Options, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return transport.Options{}, nil
+	}
+
+	v, err := readConfigFile()
+	if err != nil {
+		logger.Default.Debug(fmt.Sprintf("Not looking up transport settings: %v", err))
+		return transport.Options{}, nil
+	}
+
+	// Config keys are case-insensitive, like host names. Host names contain dots, which viper
+	// treats as key separators, so the hosts are looked up in the map rather than by path.
+	settings, ok := v.GetStringMap(hostsConfigKey)[strings.ToLower(u.Host)]
+	if !ok {
+		return transport.Options{}, nil
+	}
+	values, ok := settings.(map[string]any)
+	if !ok {
+		return transport.Options{}, fmt.Errorf("invalid transport settings for %s in config file: expected ca-cert, client-cert, client-key or proxy", u.Host)
+	}
+
+	logger.Default.Debug(fmt.Sprintf("Using transport settings configured for %s", u.Host))
+	return transport.Options{
+		CACert:     stringSetting(values, "ca-cert"),
+		ClientCert: stringSetting(values, "client-cert"),
+		ClientKey:  stringSetting(values, "client-key"),
+		Proxy:      stringSetting(values, "proxy"),
+	}, nil
+}
+
+// stringSetting returns a setting of a config file map as a string
+func stringSetting(values map[string]any, key string) string {
+	if value, ok := values[key]; ok && value != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return ""
+}
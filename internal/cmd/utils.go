@@ -1,6 +1,12 @@
 package cmd
 
-import "github.com/pterm/pterm"
+import (
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
 
 func printBanner() {
 	ivrit := `
@@ -18,3 +24,20 @@ func printBanner() {
 	pterm.Printf("version: %s | compiled: %s\n", pterm.LightGreen(Version), pterm.LightGreen(Date))
 	pterm.Println(pterm.Cyan("🔗 https://openfeature.dev | https://github.com/open-feature/cli"))
 }
+
+// tagFilterOptions builds the filter options from the --include-tag and --exclude-tag flags
+func tagFilterOptions(cmd *cobra.Command) flagset.FilterOptions {
+	return flagset.FilterOptions{
+		IncludeTags: config.GetIncludeTags(cmd),
+		ExcludeTags: config.GetExcludeTags(cmd),
+	}
+}
+
+// loadFilteredFlagSet loads the manifest and keeps only the flags selected by the tag filter flags
+func loadFilteredFlagSet(cmd *cobra.Command, manifestPath string) (*flagset.Flagset, error) {
+	fs, err := manifest.LoadFlagSet(manifestPath, manifestLoadOptions(cmd))
+	if err != nil {
+		return nil, err
+	}
+	return fs.Filter(tagFilterOptions(cmd)), nil
+}
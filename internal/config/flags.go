@@ -4,43 +4,75 @@ import (
 	"os"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 	"golang.org/x/term"
 )
 
 // Flag name constants to avoid duplication
 const (
-	DebugFlagName         = "debug"
-	ManifestFlagName      = "manifest"
-	OutputFlagName        = "output"
-	NoInputFlagName       = "no-input"
-	GoPackageFlagName     = "package-name"
-	CSharpNamespaceName   = "namespace"
-	OverrideFlagName      = "override"
-	JavaPackageFlagName   = "package-name"
-	ProviderURLFlagName   = "provider-url"
-	FlagSourceURLFlagName = "flag-source-url" // Deprecated: use ProviderFlagName instead
-	AuthTokenFlagName     = "auth-token"
-	NoPromptFlagName      = "no-prompt"
-	DryRunFlagName        = "dry-run"
-	TypeFlagName          = "type"
-	DefaultValueFlagName  = "default-value"
-	DescriptionFlagName   = "description"
-	TemplateFlagName      = "template"
+	DebugFlagName            = "debug"
+	ManifestFlagName         = "manifest"
+	OutputFlagName           = "output"
+	NoInputFlagName          = "no-input"
+	GoPackageFlagName        = "package-name"
+	CSharpNamespaceName      = "namespace"
+	OverrideFlagName         = "override"
+	JavaPackageFlagName      = "package-name"
+	ProviderURLFlagName      = "provider-url"
+	FlagSourceURLFlagName    = "flag-source-url" // Deprecated: use ProviderFlagName instead
+	AuthTokenFlagName        = "auth-token"
+	NoPromptFlagName         = "no-prompt"
+	DryRunFlagName           = "dry-run"
+	TypeFlagName             = "type"
+	DefaultValueFlagName     = "default-value"
+	DescriptionFlagName      = "description"
+	TemplateFlagName         = "template"
+	WithinDaysFlagName       = "within-days"
+	IncludeTagFlagName       = "include-tag"
+	ExcludeTagFlagName       = "exclude-tag"
+	FragmentFlagName         = "fragment"
+	FromFlagName             = "from"
+	FormatFlagName           = "format"
+	TestFixturesFlagName     = "test-fixtures"
+	AddressFlagName          = "address"
+	CapabilitiesFlagName     = "capabilities"
+	OfflineFlagName          = "offline"
+	CacheDirFlagName         = "cache-dir"
+	AuthTokenFileFlagName    = "auth-token-file"
+	CredentialHelperFlagName = "credential-helper"
+	AuthModeFlagName         = "auth-mode"
+	OAuth2TokenURLFlagName   = "oauth2-token-url"
+	OAuth2ClientIDFlagName   = "oauth2-client-id"
+	OAuth2SecretFileFlagName = "oauth2-client-secret-file"
+	OAuth2ScopesFlagName     = "oauth2-scopes"
+	CACertFlagName           = "ca-cert"
+	ClientCertFlagName       = "client-cert"
+	ClientKeyFlagName        = "client-key"
+	ProxyFlagName            = "proxy"
+	ProfileFlagName          = "profile"
+	BaseFlagName             = "base"
+	IgnoreFlagName           = "ignore"
 )
 
 // Default values for flags
 const (
-	DefaultManifestPath    = "flags.json"
-	DefaultOutputPath      = ""
-	DefaultGoPackageName   = "openfeature"
-	DefaultCSharpNamespace = "OpenFeature"
-	DefaultJavaPackageName = "com.example.openfeature"
+	DefaultManifestPath      = "flags.json"
+	DefaultOutputPath        = ""
+	DefaultGoPackageName     = "openfeature"
+	DefaultCSharpNamespace   = "OpenFeature"
+	DefaultJavaPackageName   = "com.example.openfeature"
+	DefaultWithinDays        = 14
+	DefaultStaleOutput       = "table"
+	DefaultServeAddress      = "localhost:8080"
+	DefaultConformanceOutput = "table"
+	DefaultAuthMode          = "bearer"
+	DefaultDriftOutput       = "table"
 )
 
 // AddRootFlags adds the common flags to the given command
 func AddRootFlags(cmd *cobra.Command) {
-	cmd.PersistentFlags().StringP(ManifestFlagName, "m", DefaultManifestPath, "Path to the flag manifest")
+	cmd.PersistentFlags().StringP(ManifestFlagName, "m", DefaultManifestPath, "Path to the flag manifest, or a directory or glob of manifest fragments")
 	cmd.PersistentFlags().Bool(NoInputFlagName, false, "Disable interactive prompts")
 	cmd.PersistentFlags().Bool(DebugFlagName, false, "Enable debug logging")
 }
@@ -49,11 +81,18 @@ func AddRootFlags(cmd *cobra.Command) {
 func AddGenerateFlags(cmd *cobra.Command) {
 	cmd.PersistentFlags().StringP(OutputFlagName, "o", DefaultOutputPath, "Path to where the generated files should be saved")
 	cmd.PersistentFlags().StringP(TemplateFlagName, "t", "", "Path to a custom template file. If not specified, the default template is used")
+	addTagFilterFlags(cmd.PersistentFlags())
 }
 
 // AddGoGenerateFlags adds the go generator specific flags to the given command
 func AddGoGenerateFlags(cmd *cobra.Command) {
 	cmd.Flags().String(GoPackageFlagName, DefaultGoPackageName, "Name of the generated Go package")
+	addTestFixturesFlag(cmd)
+}
+
+// AddNodeJSGenerateFlags adds the Node.js generator specific flags to the given command
+func AddNodeJSGenerateFlags(cmd *cobra.Command) {
+	addTestFixturesFlag(cmd)
 }
 
 // AddCSharpGenerateFlags adds the C# generator specific flags to the given command
@@ -64,6 +103,17 @@ func AddCSharpGenerateFlags(cmd *cobra.Command) {
 // AddJavaGenerateFlags adds the Java generator specific flags to the given command
 func AddJavaGenerateFlags(cmd *cobra.Command) {
 	cmd.Flags().String(JavaPackageFlagName, DefaultJavaPackageName, "Name of the generated Java package")
+	addTestFixturesFlag(cmd)
+}
+
+func addTestFixturesFlag(cmd *cobra.Command) {
+	cmd.Flags().Bool(TestFixturesFlagName, false, "Also generate an in-memory provider configuration populated with the manifest defaults for use in tests")
+}
+
+// GetTestFixtures gets the test-fixtures flag from the given command
+func GetTestFixtures(cmd *cobra.Command) bool {
+	testFixtures, _ := cmd.Flags().GetBool(TestFixturesFlagName)
+	return testFixtures
 }
 
 // AddInitFlags adds the init command specific flags
@@ -80,7 +130,11 @@ func AddPullFlags(cmd *cobra.Command) {
 	cmd.Flags().String(FlagSourceURLFlagName, "", "The URL of the flag source (deprecated: use --provider-url instead)")
 	_ = cmd.Flags().MarkDeprecated(FlagSourceURLFlagName, "use --provider-url instead")
 	cmd.Flags().String(AuthTokenFlagName, "", "The auth token for the flag provider")
+	addCredentialFlags(cmd.Flags())
+	AddProfileFlag(cmd)
 	cmd.Flags().Bool(NoPromptFlagName, false, "Disable interactive prompts for missing default values")
+	cmd.Flags().Bool(OfflineFlagName, false, "Use the last pulled copy of the manifest without contacting the provider")
+	cmd.Flags().String(CacheDirFlagName, "", "Directory for cached copies of pulled manifests (defaults to the user cache directory)")
 }
 
 // AddPushFlags adds the push command specific flags
@@ -89,7 +143,23 @@ func AddPushFlags(cmd *cobra.Command) {
 	cmd.Flags().String(FlagSourceURLFlagName, "", "The URL of the flag destination (deprecated: use --provider-url instead)")
 	_ = cmd.Flags().MarkDeprecated(FlagSourceURLFlagName, "use --provider-url instead")
 	cmd.Flags().String(AuthTokenFlagName, "", "The auth token for the flag provider")
+	addCredentialFlags(cmd.Flags())
+	AddProfileFlag(cmd)
 	cmd.Flags().Bool(DryRunFlagName, false, "Preview changes without pushing")
+	cmd.Flags().String(CacheDirFlagName, "", "Directory for cached copies of pulled manifests, which are updated after a push (defaults to the user cache directory)")
+	addTagFilterFlags(cmd.Flags())
+}
+
+// AddRemoteSourceFlags adds the flags for authenticating with and connecting to a remote flag source,
+// for commands that read from a provider in addition to local manifests
+func AddRemoteSourceFlags(cmd *cobra.Command) {
+	cmd.Flags().String(AuthTokenFlagName, "", "The auth token for the flag provider")
+	addCredentialFlags(cmd.Flags())
+}
+
+// AddProfileFlag adds the flag selecting a named profile from the config file
+func AddProfileFlag(cmd *cobra.Command) {
+	cmd.Flags().String(ProfileFlagName, "", "Named profile from the profiles section of the config file, e.g. staging")
 }
 
 // GetManifestPath gets the manifest path from the given command
@@ -178,6 +248,93 @@ func GetAuthToken(cmd *cobra.Command) string {
 	return authToken
 }
 
+// addCredentialFlags adds the flags for reading the auth token from somewhere other than the command line,
+// for the OAuth2 client credentials flow and for the TLS and proxy settings of the connection
+func addCredentialFlags(flags *pflag.FlagSet) {
+	flags.String(AuthTokenFileFlagName, "", "Path to a file containing the auth token for the flag provider")
+	flags.String(CredentialHelperFlagName, "", "Command that prints the auth token for the flag provider, called like a git credential helper")
+	flags.String(AuthModeFlagName, DefaultAuthMode, "How to authenticate with the flag provider. Valid modes: bearer, oauth2")
+	flags.String(OAuth2TokenURLFlagName, "", "Token endpoint of the OAuth2 authorization server (auth mode oauth2)")
+	flags.String(OAuth2ClientIDFlagName, "", "OAuth2 client ID (auth mode oauth2)")
+	flags.String(OAuth2SecretFileFlagName, "", "Path to a file containing the OAuth2 client secret (auth mode oauth2)")
+	flags.StringSlice(OAuth2ScopesFlagName, nil, "OAuth2 scopes to request (auth mode oauth2)")
+	flags.String(CACertFlagName, "", "Path to a PEM file with CA certificates to trust in addition to the system ones")
+	flags.String(ClientCertFlagName, "", "Path to a PEM client certificate for mutual TLS")
+	flags.String(ClientKeyFlagName, "", "Path to the PEM private key of the client certificate")
+	flags.String(ProxyFlagName, "", "URL of the proxy for requests to the flag provider (default from HTTP_PROXY, HTTPS_PROXY and NO_PROXY)")
+}
+
+// GetAuthMode gets the auth mode from the given command
+func GetAuthMode(cmd *cobra.Command) string {
+	authMode, _ := cmd.Flags().GetString(AuthModeFlagName)
+	return authMode
+}
+
+// GetOAuth2TokenURL gets the OAuth2 token URL from the given command
+func GetOAuth2TokenURL(cmd *cobra.Command) string {
+	tokenURL, _ := cmd.Flags().GetString(OAuth2TokenURLFlagName)
+	return tokenURL
+}
+
+// GetOAuth2ClientID gets the OAuth2 client ID from the given command
+func GetOAuth2ClientID(cmd *cobra.Command) string {
+	clientID, _ := cmd.Flags().GetString(OAuth2ClientIDFlagName)
+	return clientID
+}
+
+// GetOAuth2ClientSecretFile gets the OAuth2 client secret file path from the given command
+func GetOAuth2ClientSecretFile(cmd *cobra.Command) string {
+	secretFile, _ := cmd.Flags().GetString(OAuth2SecretFileFlagName)
+	return secretFile
+}
+
+// GetOAuth2Scopes gets the OAuth2 scopes from the given command
+func GetOAuth2Scopes(cmd *cobra.Command) []string {
+	scopes, _ := cmd.Flags().GetStringSlice(OAuth2ScopesFlagName)
+	return scopes
+}
+
+// GetCACert gets the CA certificate file path from the given command
+func GetCACert(cmd *cobra.Command) string {
+	caCert, _ := cmd.Flags().GetString(CACertFlagName)
+	return caCert
+}
+
+// GetClientCert gets the client certificate file path from the given command
+func GetClientCert(cmd *cobra.Command) string {
+	clientCert, _ := cmd.Flags().GetString(ClientCertFlagName)
+	return clientCert
+}
+
+// GetClientKey gets the client key file path from the given command
+func GetClientKey(cmd *cobra.Command) string {
+	clientKey, _ := cmd.Flags().GetString(ClientKeyFlagName)
+	return clientKey
+}
+
+// GetProxy gets the proxy URL from the given command
+func GetProxy(cmd *cobra.Command) string {
+	proxy, _ := cmd.Flags().GetString(ProxyFlagName)
+	return proxy
+}
+
+// GetAuthTokenFile gets the auth token file path from the given command
+func GetAuthTokenFile(cmd *cobra.Command) string {
+	authTokenFile, _ := cmd.Flags().GetString(AuthTokenFileFlagName)
+	return authTokenFile
+}
+
+// GetCredentialHelper gets the credential helper command from the given command
+func GetCredentialHelper(cmd *cobra.Command) string {
+	helper, _ := cmd.Flags().GetString(CredentialHelperFlagName)
+	return helper
+}
+
+// IsSecretFlag reports whether the value of the named flag must not be logged
+func IsSecretFlag(name string) bool {
+	return name == AuthTokenFlagName
+}
+
 // GetNoPrompt gets the no-prompt flag from the given command
 func GetNoPrompt(cmd *cobra.Command) bool {
 	noPrompt, _ := cmd.Flags().GetBool(NoPromptFlagName)
@@ -190,16 +347,105 @@ func GetDryRun(cmd *cobra.Command) bool {
 	return dryRun
 }
 
+// GetOffline gets the offline flag from the given command
+func GetOffline(cmd *cobra.Command) bool {
+	offline, _ := cmd.Flags().GetBool(OfflineFlagName)
+	return offline
+}
+
+// GetCacheDir gets the cache directory from the given command
+func GetCacheDir(cmd *cobra.Command) string {
+	cacheDir, _ := cmd.Flags().GetString(CacheDirFlagName)
+	return cacheDir
+}
+
+// AddServeFlags adds the serve command specific flags
+func AddServeFlags(cmd *cobra.Command) {
+	cmd.Flags().String(AddressFlagName, DefaultServeAddress, "Address the server listens on")
+	cmd.Flags().String(AuthTokenFlagName, "", "Bearer token clients must send. Requests are not authenticated when empty")
+	cmd.Flags().StringSlice(CapabilitiesFlagName, []string{"read", "write", "delete"}, "Capabilities granted to clients. Valid capabilities: read, write, delete")
+}
+
+// GetAddress gets the listen address from the given command
+func GetAddress(cmd *cobra.Command) string {
+	address, _ := cmd.Flags().GetString(AddressFlagName)
+	return address
+}
+
+// GetCapabilities gets the capabilities from the given command
+func GetCapabilities(cmd *cobra.Command) []string {
+	capabilities, _ := cmd.Flags().GetStringSlice(CapabilitiesFlagName)
+	return capabilities
+}
+
+// AddAPIConformanceFlags adds the api conformance command specific flags
+func AddAPIConformanceFlags(cmd *cobra.Command) {
+	cmd.Flags().String(ProviderURLFlagName, "", "The URL of the provider to check (required)")
+	cmd.Flags().String(AuthTokenFlagName, "", "The auth token for the provider. Requires read, write and delete access")
+	addCredentialFlags(cmd.Flags())
+	cmd.Flags().StringP(OutputFlagName, "o", DefaultConformanceOutput, "Output format. Valid formats: table, json")
+}
+
+// AddDriftFlags adds the drift command specific flags
+func AddDriftFlags(cmd *cobra.Command) {
+	cmd.Flags().String(ProviderURLFlagName, "", "The URL of the flag provider")
+	AddRemoteSourceFlags(cmd)
+	AddProfileFlag(cmd)
+	cmd.Flags().String(BaseFlagName, "", "Path or URL of the manifest both sides last agreed on (defaults to the copy from the last pull or push)")
+	cmd.Flags().String(CacheDirFlagName, "", "Directory for cached copies of pulled manifests (defaults to the user cache directory)")
+	cmd.Flags().StringArrayP(IgnoreFlagName, "i", []string{}, "Field pattern to ignore (can be specified multiple times), as for compare")
+	cmd.Flags().StringP(OutputFlagName, "o", DefaultDriftOutput, "Output format. Valid formats: table, json, markdown")
+	addTagFilterFlags(cmd.Flags())
+}
+
+// GetBase gets the base manifest location from the given command
+func GetBase(cmd *cobra.Command) string {
+	base, _ := cmd.Flags().GetString(BaseFlagName)
+	return base
+}
+
 // AddManifestAddFlags adds the manifest add command specific flags
 func AddManifestAddFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP(TypeFlagName, "t", "boolean", "Type of the flag (boolean, string, integer, float, object)")
 	cmd.Flags().StringP(DefaultValueFlagName, "d", "", "Default value for the flag (required)")
 	cmd.Flags().String(DescriptionFlagName, "", "Description of the flag")
+	cmd.Flags().String(FragmentFlagName, "", "Manifest fragment to write the flag to when --manifest is a directory or glob")
+}
+
+// GetFragment gets the manifest fragment path from the given command
+func GetFragment(cmd *cobra.Command) string {
+	fragment, _ := cmd.Flags().GetString(FragmentFlagName)
+	return fragment
 }
 
 // AddManifestListFlags adds the manifest list command specific flags
 func AddManifestListFlags(cmd *cobra.Command) {
-	// Currently no specific flags for list command, but function exists for consistency
+	addTagFilterFlags(cmd.Flags())
+}
+
+// AddManifestImportFlags adds the manifest import command specific flags
+func AddManifestImportFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FromFlagName, "", "Format of the file to import. Valid formats: flagd, gofeatureflag, env (required)")
+	cmd.Flags().Bool(OverrideFlagName, false, "Replace flags that already exist in the manifest")
+}
+
+// GetImportFormat gets the import format from the given command
+func GetImportFormat(cmd *cobra.Command) string {
+	format, _ := cmd.Flags().GetString(FromFlagName)
+	return format
+}
+
+// AddManifestExportFlags adds the manifest export command specific flags
+func AddManifestExportFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FormatFlagName, "flagd", "Format to export the manifest to. Valid formats: flagd")
+	cmd.Flags().StringP(OutputFlagName, "o", "", "Path of the file to write. Writes to stdout when not set")
+	addTagFilterFlags(cmd.Flags())
+}
+
+// GetExportFormat gets the export format from the given command
+func GetExportFormat(cmd *cobra.Command) string {
+	format, _ := cmd.Flags().GetString(FormatFlagName)
+	return format
 }
 
 // AddManifestDeleteFlags adds the manifest delete command specific flags
@@ -207,6 +453,40 @@ func AddManifestDeleteFlags(cmd *cobra.Command) {
 	// Currently no specific flags for delete command, but function exists for consistency
 }
 
+// AddManifestStaleFlags adds the manifest stale command specific flags
+func AddManifestStaleFlags(cmd *cobra.Command) {
+	cmd.Flags().Int(WithinDaysFlagName, DefaultWithinDays, "Also report flags that expire within this many days")
+	cmd.Flags().StringP(OutputFlagName, "o", DefaultStaleOutput, "Output format. Valid formats: table, json")
+}
+
+// GetWithinDays gets the within-days flag from the given command
+func GetWithinDays(cmd *cobra.Command) int {
+	withinDays, _ := cmd.Flags().GetInt(WithinDaysFlagName)
+	return withinDays
+}
+
+// AddTagFilterFlags adds the flags used to select flags by tag to the given command
+func AddTagFilterFlags(cmd *cobra.Command) {
+	addTagFilterFlags(cmd.Flags())
+}
+
+func addTagFilterFlags(flags *pflag.FlagSet) {
+	flags.StringSlice(IncludeTagFlagName, nil, "Only include flags with at least one of these tags (can be repeated or comma-separated)")
+	flags.StringSlice(ExcludeTagFlagName, nil, "Exclude flags with any of these tags (can be repeated or comma-separated)")
+}
+
+// GetIncludeTags gets the include-tag values from the given command
+func GetIncludeTags(cmd *cobra.Command) []string {
+	tags, _ := cmd.Flags().GetStringSlice(IncludeTagFlagName)
+	return tags
+}
+
+// GetExcludeTags gets the exclude-tag values from the given command
+func GetExcludeTags(cmd *cobra.Command) []string {
+	tags, _ := cmd.Flags().GetStringSlice(ExcludeTagFlagName)
+	return tags
+}
+
 // ShouldDisableInteractivePrompts returns true if interactive prompts should be disabled
 // This happens when:
 // - The --no-input flag is set, OR
@@ -0,0 +1,125 @@
+// Package credentials resolves the auth tokens sent to flag providers
+package credentials
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/logger"
+	"github.com/spf13/afero"
+)
+
+const (
+	// TokenEnvVar is the environment variable holding the auth token
+	TokenEnvVar = "OPENFEATURE_AUTH_TOKEN"
+	// ClientSecretEnvVar is the environment variable holding the OAuth2 client secret
+	ClientSecretEnvVar = "OPENFEATURE_OAUTH2_CLIENT_SECRET"
+)
+
+// Options configures where Resolve looks for an auth token
+type Options struct {
+	// Token is a token given explicitly, e.g. with --auth-token
+	Token string
+	// EnvVar is the environment variable holding the token and defaults to TokenEnvVar
+	EnvVar string
+	// TokenFile is the path of a file containing the token
+	TokenFile string
+	// Helper is a credential helper command, run with the "get" argument
+	Helper string
+	// ProviderURL is the provider the token is for, passed to the credential helper
+	ProviderURL string
+}
+
+// Resolve returns the auth token from the first source that has one: the explicit token,
+// the OPENFEATURE_AUTH_TOKEN environment variable, the token file and finally the credential
+// helper. It returns an empty token when no source is configured.
+func Resolve(ctx context.Context, opts Options) (string, error) {
+	if opts.Token != "" {
+		logger.Default.Debug("Using auth token from --auth-token")
+		return opts.Token, nil
+	}
+
+	envVar := opts.EnvVar
+	if envVar == "" {
+		envVar = TokenEnvVar
+	}
+	if token := os.Getenv(envVar); token != "" {
+		logger.Default.Debug(fmt.Sprintf("Using auth token from %s", envVar))
+		return token, nil
+	}
+
+	if opts.TokenFile != "" {
+		data, err := afero.ReadFile(filesystem.FileSystem(), opts.TokenFile)
+		if err != nil {
+			return "", fmt.Errorf("error reading auth token file: %w", err)
+		}
+		token := strings.TrimSpace(string(data))
+		if token == "" {
+			return "", fmt.Errorf("auth token file %s is empty", opts.TokenFile)
+		}
+		logger.Default.Debug(fmt.Sprintf("Using auth token from file %s", opts.TokenFile))
+		return token, nil
+	}
+
+	if opts.Helper != "" {
+		token, err := FromHelper(ctx, opts.Helper, opts.ProviderURL)
+		if err != nil {
+			return "", err
+		}
+		logger.Default.Debug(fmt.Sprintf("Using auth token from credential helper %q", opts.Helper))
+		return token, nil
+	}
+
+	return "", nil
+}
+
+// FromHelper runs a credential helper the way git runs its credential helpers: the command is
+// run through the shell with the "get" argument, receives the protocol and host of the provider
+// as key=value lines on stdin and prints the token as a password=<token> line.
+func FromHelper(ctx context.Context, helper string, providerURL string) (string, error) {
+	var input bytes.Buffer
+	if u, err := url.Parse(providerURL); err == nil && u.Host != "" {
+		fmt.Fprintf(&input, "protocol=%s\nhost=%s\n", u.Scheme, u.Host)
+	}
+	input.WriteString("\n")
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", helper+" get")
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", helper+" get")
+	}
+	cmd.Stdin = &input
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("credential helper %q failed: %w: %s", helper, err, strings.TrimSpace(stderr.String()))
+	}
+
+	scanner := bufio.NewScanner(&stdout)
+	for scanner.Scan() {
+		key, value, found := strings.Cut(scanner.Text(), "=")
+		if found && key == "password" && value != "" {
+			return value, nil
+		}
+	}
+	return "", fmt.Errorf("credential helper %q did not return a password", helper)
+}
+
+// Redact hides a token in log output, keeping only whether one is set
+func Redact(token string) string {
+	if token == "" {
+		return "<none>"
+	}
+	return "<redacted>"
+}
@@ -0,0 +1,65 @@
+package credentials
+
+import (
+	"context"
+	"os/exec"
+	"testing"
+
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolve(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh is not installed")
+	}
+
+	fs := afero.NewMemMapFs()
+	filesystem.SetFileSystem(fs)
+	t.Cleanup(func() { filesystem.SetFileSystem(afero.NewOsFs()) })
+	require.NoError(t, afero.WriteFile(fs, "token", []byte("file-token\n"), 0o600))
+	require.NoError(t, afero.WriteFile(fs, "empty", []byte("\n"), 0o600))
+
+	// The helper echoes the host it was asked about, so the test can check what it received
+	helper := `f() { sed -n 's/^host=/password=helper-token-for-/p'; }; f`
+
+	tests := []struct {
+		name          string
+		env           string
+		opts          Options
+		expected      string
+		expectedError string
+	}{
+		{name: "no sources", expected: ""},
+		{name: "explicit token wins", env: "env-token", opts: Options{Token: "flag-token", TokenFile: "token", Helper: helper}, expected: "flag-token"},
+		{name: "environment before file", env: "env-token", opts: Options{TokenFile: "token", Helper: helper}, expected: "env-token"},
+		{name: "file before helper", opts: Options{TokenFile: "token", Helper: helper}, expected: "file-token"},
+		{name: "helper", opts: Options{Helper: helper, ProviderURL: "https://flags.example.com/api"}, expected: "helper-token-for-flags.example.com"},
+		{name: "missing file", opts: Options{TokenFile: "missing"}, expectedError: "error reading auth token file"},
+		{name: "empty file", opts: Options{TokenFile: "empty"}, expectedError: "auth token file empty is empty"},
+		{name: "failing helper", opts: Options{Helper: "echo denied >&2; exit 1;"}, expectedError: "denied"},
+		{name: "helper without password", opts: Options{Helper: "echo username=ci"}, expectedError: "did not return a password"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(TokenEnvVar, tt.env)
+
+			token, err := Resolve(context.Background(), tt.opts)
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, token)
+		})
+	}
+}
+
+func TestRedact(t *testing.T) {
+	assert.Equal(t, "<none>", Redact(""))
+	assert.Equal(t, "<redacted>", Redact("secret-token"))
+	assert.NotContains(t, Redact("secret-token"), "secret")
+}
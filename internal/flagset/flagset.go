@@ -3,6 +3,7 @@ package flagset
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
 	"sort"
 )
 
@@ -36,24 +37,71 @@ func (f FlagType) String() string {
 	}
 }
 
+// Lifecycle describes whether a flag is expected to be removed eventually.
+type Lifecycle string
+
+// Collection of the supported flag lifecycles
+const (
+	LifecycleTemporary Lifecycle = "temporary"
+	LifecyclePermanent Lifecycle = "permanent"
+)
+
 type Flag struct {
 	Key          string
 	Type         FlagType
 	Description  string
 	DefaultValue any
+	// Name is a human-friendly display name. Empty when the key is used as the name.
+	Name string
+	// Owner is the team or individual responsible for the flag.
+	Owner string
+	// CreatedAt is the date the flag was created (YYYY-MM-DD).
+	CreatedAt string
+	// ExpiresAt is the date after which the flag is considered stale (YYYY-MM-DD).
+	ExpiresAt string
+	Lifecycle Lifecycle
+	// Deprecated explains why the flag is deprecated and what to use instead. Empty when not deprecated.
+	Deprecated string
+	// RenamedFrom is the key the flag had before it was renamed. Empty when the flag was not renamed.
+	RenamedFrom string
+	// Tags group flags, for example by the applications that use them.
+	Tags []string
 }
 
 type Flagset struct {
 	Flags []Flag
 }
 
-// Filter removes flags from the Flagset that are of unsupported types.
-func (fs *Flagset) Filter(unsupportedFlagTypes map[FlagType]bool) *Flagset {
+// FilterOptions controls which flags are kept by Flagset.Filter.
+type FilterOptions struct {
+	// UnsupportedFlagTypes lists the flag types that are removed.
+	UnsupportedFlagTypes map[FlagType]bool
+	// IncludeTags keeps only flags that have at least one of these tags. Empty keeps all flags.
+	IncludeTags []string
+	// ExcludeTags removes flags that have any of these tags.
+	ExcludeTags []string
+}
+
+// MatchesTags reports whether a flag with the given tags passes the tag filters.
+func (o FilterOptions) MatchesTags(tags []string) bool {
+	if len(o.IncludeTags) > 0 && !slices.ContainsFunc(tags, func(tag string) bool {
+		return slices.Contains(o.IncludeTags, tag)
+	}) {
+		return false
+	}
+	return !slices.ContainsFunc(tags, func(tag string) bool {
+		return slices.Contains(o.ExcludeTags, tag)
+	})
+}
+
+// Filter returns a new Flagset containing only the flags that match the given options.
+func (fs *Flagset) Filter(opts FilterOptions) *Flagset {
 	var filtered Flagset
 	for _, flag := range fs.Flags {
-		if !unsupportedFlagTypes[flag.Type] {
-			filtered.Flags = append(filtered.Flags, flag)
+		if opts.UnsupportedFlagTypes[flag.Type] || !opts.MatchesTags(flag.Tags) {
+			continue
 		}
+		filtered.Flags = append(filtered.Flags, flag)
 	}
 	return &filtered
 }
@@ -76,14 +124,25 @@ func ParseFlagType(typeStr string) (FlagType, error) {
 	}
 }
 
+// manifestFlag is the JSON representation of a single flag in the manifest.
+type manifestFlag struct {
+	FlagType     string   `json:"flagType"`
+	Name         string   `json:"name,omitempty"`
+	Description  string   `json:"description"`
+	DefaultValue any      `json:"defaultValue"`
+	Owner        string   `json:"owner,omitempty"`
+	CreatedAt    string   `json:"createdAt,omitempty"`
+	ExpiresAt    string   `json:"expiresAt,omitempty"`
+	Lifecycle    string   `json:"lifecycle,omitempty"`
+	Deprecated   string   `json:"deprecated,omitempty"`
+	RenamedFrom  string   `json:"renamedFrom,omitempty"`
+	Tags         []string `json:"tags,omitempty"`
+}
+
 // UnmarshalJSON unmarshals the JSON data into a Flagset. It is used by json.Unmarshal.
 func (fs *Flagset) UnmarshalJSON(data []byte) error {
 	var manifest struct {
-		Flags map[string]struct {
-			FlagType     string `json:"flagType"`
-			Description  string `json:"description"`
-			DefaultValue any    `json:"defaultValue"`
-		} `json:"flags"`
+		Flags map[string]manifestFlag `json:"flags"`
 	}
 
 	if err := json.Unmarshal(data, &manifest); err != nil {
@@ -98,9 +157,17 @@ func (fs *Flagset) UnmarshalJSON(data []byte) error {
 
 		fs.Flags = append(fs.Flags, Flag{
 			Key:          key,
+			Name:         flag.Name,
 			Type:         flagType,
 			Description:  flag.Description,
 			DefaultValue: flag.DefaultValue,
+			Owner:        flag.Owner,
+			CreatedAt:    flag.CreatedAt,
+			ExpiresAt:    flag.ExpiresAt,
+			Lifecycle:    Lifecycle(flag.Lifecycle),
+			Deprecated:   flag.Deprecated,
+			RenamedFrom:  flag.RenamedFrom,
+			Tags:         flag.Tags,
 		})
 	}
 
@@ -115,28 +182,24 @@ func (fs *Flagset) UnmarshalJSON(data []byte) error {
 // MarshalJSON marshals a Flagset into JSON format compatible with the manifest structure
 func (fs *Flagset) MarshalJSON() ([]byte, error) {
 	manifest := struct {
-		Flags map[string]struct {
-			FlagType     string `json:"flagType"`
-			Description  string `json:"description"`
-			DefaultValue any    `json:"defaultValue"`
-		} `json:"flags"`
+		Flags map[string]manifestFlag `json:"flags"`
 	}{
-		Flags: make(map[string]struct {
-			FlagType     string `json:"flagType"`
-			Description  string `json:"description"`
-			DefaultValue any    `json:"defaultValue"`
-		}),
+		Flags: make(map[string]manifestFlag),
 	}
 
 	for _, flag := range fs.Flags {
-		manifest.Flags[flag.Key] = struct {
-			FlagType     string `json:"flagType"`
-			Description  string `json:"description"`
-			DefaultValue any    `json:"defaultValue"`
-		}{
+		manifest.Flags[flag.Key] = manifestFlag{
 			FlagType:     flag.Type.String(),
+			Name:         flag.Name,
 			Description:  flag.Description,
 			DefaultValue: flag.DefaultValue,
+			Owner:        flag.Owner,
+			CreatedAt:    flag.CreatedAt,
+			ExpiresAt:    flag.ExpiresAt,
+			Lifecycle:    string(flag.Lifecycle),
+			Deprecated:   flag.Deprecated,
+			RenamedFrom:  flag.RenamedFrom,
+			Tags:         flag.Tags,
 		}
 	}
 
@@ -146,6 +209,7 @@ func (fs *Flagset) MarshalJSON() ([]byte, error) {
 func LoadFromSourceFlags(data []byte) (*[]Flag, error) {
 	type SourceFlag struct {
 		Key          string `json:"key"`
+		Name         string `json:"name"`
 		Type         string `json:"type"`
 		Description  string `json:"description"`
 		DefaultValue any    `json:"defaultValue"`
@@ -177,6 +241,7 @@ func LoadFromSourceFlags(data []byte) (*[]Flag, error) {
 
 		flags = append(flags, Flag{
 			Key:          sf.Key,
+			Name:         sf.Name,
 			Type:         flagType,
 			Description:  sf.Description,
 			DefaultValue: sf.DefaultValue,
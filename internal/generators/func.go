@@ -32,9 +32,16 @@ func defaultFuncs() template.FuncMap {
 			}
 			return input
 		},
+		"CommentSafe": commentSafe,
 	}
 }
 
+// commentSafe makes text safe to place in a single-line or block comment by collapsing
+// line breaks into spaces and escaping the end of a block comment
+func commentSafe(text string) string {
+	return strings.ReplaceAll(strings.Join(strings.Fields(text), " "), "*/", `*\/`)
+}
+
 func init() {
 	// results in "Api" using ToCamel("API")
 	// results in "api" using ToLowerCamel("API")
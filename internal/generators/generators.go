@@ -38,9 +38,9 @@ type TemplateData struct {
 }
 
 // NewGenerator creates a new generator
-func NewGenerator(flagset *flagset.Flagset, UnsupportedFlagTypes map[flagset.FlagType]bool) *CommonGenerator {
+func NewGenerator(fs *flagset.Flagset, UnsupportedFlagTypes map[flagset.FlagType]bool) *CommonGenerator {
 	return &CommonGenerator{
-		Flagset: flagset.Filter(UnsupportedFlagTypes),
+		Flagset: fs.Filter(flagset.FilterOptions{UnsupportedFlagTypes: UnsupportedFlagTypes}),
 	}
 }
 
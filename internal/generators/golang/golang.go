@@ -27,6 +27,9 @@ type Params struct {
 //go:embed golang.tmpl
 var golangTmpl string
 
+//go:embed golang_fixtures.tmpl
+var golangFixturesTmpl string
+
 func openFeatureType(t flagset.FlagType) string {
 	switch t {
 	case flagset.IntType:
@@ -128,6 +131,21 @@ func formatNestedValue(value any) string {
 	}
 }
 
+// fixtureValue returns the Go literal used as the in-memory provider variant for the flag's default value.
+// Numbers are typed explicitly because the in-memory provider asserts int64 and float64 variants.
+func fixtureValue(flag flagset.Flag) string {
+	switch flag.Type {
+	case flagset.IntType:
+		return fmt.Sprintf("int64(%v)", flag.DefaultValue)
+	case flagset.FloatType:
+		return fmt.Sprintf("float64(%v)", flag.DefaultValue)
+	case flagset.ObjectType:
+		return toMapLiteral(flag.DefaultValue)
+	default:
+		return formatNestedValue(flag.DefaultValue)
+	}
+}
+
 func (g *GolangGenerator) Generate(params *generators.Params[Params]) error {
 	funcs := template.FuncMap{
 		"SupportImports":  supportImports,
@@ -149,6 +167,24 @@ func (g *GolangGenerator) Generate(params *generators.Params[Params]) error {
 	return g.GenerateFile(funcs, golangTmpl, newParams, filename)
 }
 
+// GenerateTestFixtures generates an in-memory provider configuration populated with the manifest defaults.
+func (g *GolangGenerator) GenerateTestFixtures(params *generators.Params[Params]) error {
+	funcs := template.FuncMap{
+		"FixtureValue": fixtureValue,
+	}
+
+	newParams := &generators.Params[any]{
+		OutputPath: params.OutputPath,
+		Custom: Params{
+			GoPackage:  params.Custom.GoPackage,
+			CLIVersion: params.Custom.CLIVersion,
+		},
+	}
+
+	filename := params.Custom.GoPackage + "_fixtures_gen.go"
+	return g.GenerateFile(funcs, golangFixturesTmpl, newParams, filename)
+}
+
 // NewGenerator creates a generator for Go.
 func NewGenerator(fs *flagset.Flagset) *GolangGenerator {
 	g := &GolangGenerator{
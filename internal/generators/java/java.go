@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"maps"
 	"slices"
+	"strconv"
 	"strings"
 	"text/template"
 
@@ -25,6 +26,9 @@ type Params struct {
 //go:embed java.tmpl
 var javaTmpl string
 
+//go:embed java_fixtures.tmpl
+var javaFixturesTmpl string
+
 func openFeatureType(t flagset.FlagType) string {
 	switch t {
 	case flagset.IntType:
@@ -129,6 +133,59 @@ func formatNestedValue(value any) string {
 	}
 }
 
+// fixtureType returns the Java type of the in-memory provider variant for the flag type.
+// The in-memory provider serves object flags as a Value.
+func fixtureType(t flagset.FlagType) string {
+	if t == flagset.ObjectType {
+		return "Value"
+	}
+	return openFeatureType(t)
+}
+
+// fixtureValue returns the Java literal used as the in-memory provider variant for the flag's default value
+func fixtureValue(flag flagset.Flag) string {
+	switch flag.Type {
+	case flagset.StringType:
+		return strconv.Quote(fmt.Sprintf("%v", flag.DefaultValue))
+	case flagset.FloatType:
+		value, _ := flag.DefaultValue.(float64)
+		literal := strconv.FormatFloat(value, 'f', -1, 64)
+		if !strings.Contains(literal, ".") {
+			literal += ".0"
+		}
+		return literal
+	case flagset.ObjectType:
+		return fmt.Sprintf("Value.objectToValue(%s)", toMapLiteral(flag.DefaultValue))
+	default:
+		return formatDefaultValueForJava(flag)
+	}
+}
+
+// hasListValues reports whether any object flag has an array in its default value, in which case
+// the fixtures use List.of and import java.util.List
+func hasListValues(flags []flagset.Flag) bool {
+	for _, flag := range flags {
+		if flag.Type == flagset.ObjectType && containsList(flag.DefaultValue) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsList(value any) bool {
+	switch val := value.(type) {
+	case []any:
+		return true
+	case map[string]any:
+		for _, elem := range val {
+			if containsList(elem) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (g *JavaGenerator) Generate(params *generators.Params[Params]) error {
 	funcs := template.FuncMap{
 		"OpenFeatureType":    openFeatureType,
@@ -145,6 +202,22 @@ func (g *JavaGenerator) Generate(params *generators.Params[Params]) error {
 	return g.GenerateFile(funcs, javaTmpl, newParams, "OpenFeature.java")
 }
 
+// GenerateTestFixtures generates an in-memory provider configuration populated with the manifest defaults.
+func (g *JavaGenerator) GenerateTestFixtures(params *generators.Params[Params]) error {
+	funcs := template.FuncMap{
+		"FixtureType":   fixtureType,
+		"FixtureValue":  fixtureValue,
+		"HasListValues": hasListValues,
+	}
+
+	newParams := &generators.Params[any]{
+		OutputPath: params.OutputPath,
+		Custom:     params.Custom,
+	}
+
+	return g.GenerateFile(funcs, javaFixturesTmpl, newParams, "OpenFeatureFixtures.java")
+}
+
 // NewGenerator creates a generator for Java.
 func NewGenerator(fs *flagset.Flagset) *JavaGenerator {
 	return &JavaGenerator{
@@ -18,6 +18,9 @@ type Params struct{}
 //go:embed nodejs.tmpl
 var nodejsTmpl string
 
+//go:embed nodejs_fixtures.tmpl
+var nodejsFixturesTmpl string
+
 func openFeatureType(t flagset.FlagType) string {
 	switch t {
 	case flagset.IntType:
@@ -58,6 +61,20 @@ func (g *NodejsGenerator) Generate(params *generators.Params[Params]) error {
 	return g.GenerateFile(funcs, nodejsTmpl, newParams, "openfeature.ts")
 }
 
+// GenerateTestFixtures generates an in-memory provider configuration populated with the manifest defaults.
+func (g *NodejsGenerator) GenerateTestFixtures(params *generators.Params[Params]) error {
+	funcs := template.FuncMap{
+		"ToJSONString": toJSONString,
+	}
+
+	newParams := &generators.Params[any]{
+		OutputPath: params.OutputPath,
+		Custom:     Params{},
+	}
+
+	return g.GenerateFile(funcs, nodejsFixturesTmpl, newParams, "openfeature-fixtures.ts")
+}
+
 // NewGenerator creates a generator for NodeJS.
 func NewGenerator(fs *flagset.Flagset) *NodejsGenerator {
 	return &NodejsGenerator{
@@ -136,6 +136,16 @@ func formatNestedValue(value any) string {
 	}
 }
 
+// hasDeprecatedFlags reports whether any flag is deprecated, in which case the warnings module is imported
+func hasDeprecatedFlags(flags []flagset.Flag) bool {
+	for _, flag := range flags {
+		if flag.Deprecated != "" {
+			return true
+		}
+	}
+	return false
+}
+
 func (g *PythonGenerator) Generate(params *generators.Params[Params]) error {
 	funcs := template.FuncMap{
 		"OpenFeatureType":         openFeatureType,
@@ -145,6 +155,7 @@ func (g *PythonGenerator) Generate(params *generators.Params[Params]) error {
 		"TypedDetailsMethodAsync": typedDetailsMethodAsync,
 		"PythonBoolLiteral":       pythonBoolLiteral,
 		"ToPythonDict":            toPythonDict,
+		"HasDeprecatedFlags":      hasDeprecatedFlags,
 	}
 
 	newParams := &generators.Params[any]{
@@ -0,0 +1,173 @@
+package manifest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/open-feature/cli/internal/api/sync"
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/open-feature/cli/internal/transport"
+)
+
+// ErrPushNotSupported is returned by backends that can only be pulled from
+var ErrPushNotSupported = errors.New("push is not supported for this source")
+
+// Backend stores a flag manifest at a remote location
+type Backend interface {
+	// Pull fetches the flags stored at the location
+	Pull(ctx context.Context) (*flagset.Flagset, error)
+	// Push replaces the flags stored at the location
+	Push(ctx context.Context, flags *flagset.Flagset) error
+}
+
+// BackendOptions configures how a backend connects to its location
+type BackendOptions struct {
+	// AuthToken is sent as a bearer token by backends that support it. OCI registries also accept
+	// credentials of the form <username>:<password>, which are exchanged for a registry token.
+	AuthToken string
+	// TokenSource overrides AuthToken for http:// and https:// sources, e.g. to send OAuth2 access tokens
+	TokenSource sync.TokenSource
+	// Annotations are recorded on pushed OCI artifacts, in addition to the creation time and flag count
+	Annotations map[string]string
+	// Transport configures custom CA certificates, client certificates and proxies for the connection
+	Transport transport.Options
+}
+
+// tokenSource returns the token source for HTTP requests
+func (o BackendOptions) tokenSource() sync.TokenSource {
+	if o.TokenSource != nil {
+		return o.TokenSource
+	}
+	return sync.StaticToken(o.AuthToken)
+}
+
+// httpClient returns the client for HTTP requests, applying the transport options
+func (o BackendOptions) httpClient(timeout time.Duration) (*http.Client, error) {
+	client, err := transport.NewClient(o.Transport, timeout)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transport settings: %w", err)
+	}
+	return client, nil
+}
+
+// SupportedSchemes lists the URL schemes accepted by NewBackend
+var SupportedSchemes = []string{"file", "http", "https", "s3", "git+https", "git+ssh", "git+file", "oci"}
+
+// NewBackend returns the backend for the scheme of the given URL
+func NewBackend(rawURL string, opts BackendOptions) (Backend, error) {
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid URL: %w", err)
+	}
+
+	switch parsedURL.Scheme {
+	case "file":
+		return &fileBackend{path: parsedURL.Path}, nil
+	case "http", "https":
+		if URLLooksLikeAFile(parsedURL.String()) {
+			httpClient, err := opts.httpClient(0)
+			if err != nil {
+				return nil, err
+			}
+			return &httpFileBackend{url: rawURL, tokens: opts.tokenSource(), httpClient: httpClient}, nil
+		}
+		httpClient, err := opts.httpClient(sync.DefaultTimeout)
+		if err != nil {
+			return nil, err
+		}
+		return &syncAPIBackend{url: rawURL, tokens: opts.tokenSource(), httpClient: httpClient}, nil
+	case "s3":
+		return newS3Backend(parsedURL, opts)
+	case "git+https", "git+ssh", "git+file":
+		return newGitBackend(parsedURL, opts)
+	case "oci":
+		return newOCIBackend(parsedURL, opts)
+	default:
+		return nil, fmt.Errorf("unsupported URL scheme: %s. Supported schemes are %s", parsedURL.Scheme, formatSchemes(SupportedSchemes))
+	}
+}
+
+// formatSchemes formats URL schemes for error messages, e.g. "file://, http:// and https://"
+func formatSchemes(schemes []string) string {
+	formatted := make([]string, len(schemes))
+	for i, scheme := range schemes {
+		formatted[i] = scheme + "://"
+	}
+	if len(formatted) == 1 {
+		return formatted[0]
+	}
+	return strings.Join(formatted[:len(formatted)-1], ", ") + " and " + formatted[len(formatted)-1]
+}
+
+// fileBackend reads a manifest from the local file system
+type fileBackend struct {
+	path string
+}
+
+func (b *fileBackend) Pull(_ context.Context) (*flagset.Flagset, error) {
+	return LoadFromLocal(b.path)
+}
+
+func (b *fileBackend) Push(_ context.Context, _ *flagset.Flagset) error {
+	return ErrPushNotSupported
+}
+
+// httpFileBackend downloads a manifest file with a plain GET request
+type httpFileBackend struct {
+	url        string
+	tokens     sync.TokenSource
+	httpClient *http.Client
+}
+
+func (b *httpFileBackend) Pull(ctx context.Context) (*flagset.Flagset, error) {
+	flags, _, err := loadFromRemoteIfModified(ctx, b.url, b.tokens, b.httpClient, sync.Validators{})
+	return flags, err
+}
+
+func (b *httpFileBackend) PullIfModified(ctx context.Context, validators sync.Validators) (*flagset.Flagset, sync.Validators, error) {
+	return loadFromRemoteIfModified(ctx, b.url, b.tokens, b.httpClient, validators)
+}
+
+func (b *httpFileBackend) Push(_ context.Context, _ *flagset.Flagset) error {
+	return ErrPushNotSupported
+}
+
+// syncAPIBackend talks to a service implementing the Manifest Management API
+type syncAPIBackend struct {
+	url        string
+	tokens     sync.TokenSource
+	httpClient *http.Client
+}
+
+func (b *syncAPIBackend) Pull(ctx context.Context) (*flagset.Flagset, error) {
+	flags, _, err := b.PullIfModified(ctx, sync.Validators{})
+	return flags, err
+}
+
+func (b *syncAPIBackend) PullIfModified(ctx context.Context, validators sync.Validators) (*flagset.Flagset, sync.Validators, error) {
+	client, err := sync.NewClientWithTokenSource(b.url, b.tokens, b.httpClient)
+	if err != nil {
+		return nil, sync.Validators{}, fmt.Errorf("failed to create sync client: %w", err)
+	}
+	return client.PullFlagsIfModified(ctx, validators)
+}
+
+func (b *syncAPIBackend) Push(_ context.Context, flags *flagset.Flagset) error {
+	_, err := saveToRemote(b.url, flags, b.tokens, b.httpClient, false)
+	return err
+}
+
+// marshalManifest encodes flags as a manifest document, as written by Write
+func marshalManifest(flags *flagset.Flagset) ([]byte, error) {
+	entries := make(map[string]any)
+	for _, flag := range flags.Flags {
+		entries[flag.Key] = flagToManifestEntry(flag)
+	}
+
+	return encodeManifest(createInitManifest(entries))
+}
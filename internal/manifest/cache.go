@@ -0,0 +1,199 @@
+package manifest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/open-feature/cli/internal/api/sync"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/open-feature/cli/internal/logger"
+	"github.com/spf13/afero"
+)
+
+// Cache keeps the last good copy of the manifests pulled from remote sources, keyed by source URL
+type Cache struct {
+	dir string
+	// now returns the fetch time of cached copies and defaults to time.Now
+	now func() time.Time
+}
+
+// cacheEntry is the cached copy of a manifest, with the validators of the response it came from
+type cacheEntry struct {
+	URL          string          `json:"url"`
+	ETag         string          `json:"etag,omitempty"`
+	LastModified string          `json:"lastModified,omitempty"`
+	FetchedAt    time.Time       `json:"fetchedAt"`
+	Manifest     json.RawMessage `json:"manifest"`
+}
+
+// conditionalBackend is implemented by backends that support conditional requests
+type conditionalBackend interface {
+	// PullIfModified returns nil flags when the manifest still matches the validators
+	PullIfModified(ctx context.Context, validators sync.Validators) (*flagset.Flagset, sync.Validators, error)
+}
+
+// PullResult holds pulled flags and where they came from
+type PullResult struct {
+	Flags *flagset.Flagset
+	// NotModified is set when the source reported that the cached copy is still current
+	NotModified bool
+	// Offline is set when the flags were served from the cache without contacting the source
+	Offline bool
+	// FetchedAt is when the flags were last fetched from the source
+	FetchedAt time.Time
+}
+
+// NewCache returns a cache that stores manifests in the given directory
+func NewCache(dir string) *Cache {
+	return &Cache{dir: dir, now: time.Now}
+}
+
+// DefaultCacheDir returns the directory for cached manifests within the user cache directory
+func DefaultCacheDir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		dir = os.TempDir()
+	}
+	return filepath.Join(dir, "openfeature", "manifests")
+}
+
+// LastFetched returns when the cached copy of the source was fetched, if there is one
+func (c *Cache) LastFetched(sourceURL string) (time.Time, bool) {
+	entry, err := c.load(sourceURL)
+	if err != nil || entry == nil {
+		return time.Time{}, false
+	}
+	return entry.FetchedAt, true
+}
+
+// LastPulled returns the cached copy of the source as a manifest, normalized like NormalizeManifest,
+// and when it was fetched. The manifest is nil when there is no cached copy.
+func (c *Cache) LastPulled(sourceURL string) (*Manifest, time.Time, error) {
+	entry, err := c.load(sourceURL)
+	if err != nil || entry == nil {
+		return nil, time.Time{}, err
+	}
+
+	flags, err := loadFlagsFromData(entry.Manifest)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("error loading cached copy of %s: %w", sourceURL, err)
+	}
+	return FromFlagset(flags), entry.FetchedAt, nil
+}
+
+// Pushed records the flags a source holds after a push as its cached copy, so that drift uses the state
+// both sides last agreed on. The copy has no validators, so the next pull fetches the manifest again.
+func (c *Cache) Pushed(sourceURL string, flags *flagset.Flagset) error {
+	return c.store(sourceURL, &PullResult{Flags: flags, FetchedAt: c.now()}, sync.Validators{})
+}
+
+// Pull fetches flags from the backend and caches them. Backends that support conditional requests
+// only transfer the manifest when it changed since the cached copy. When offline, the cached copy
+// is returned without contacting the source.
+func (c *Cache) Pull(ctx context.Context, sourceURL string, backend Backend, offline bool) (*PullResult, error) {
+	entry, err := c.load(sourceURL)
+	if err != nil {
+		logger.Default.Debug(fmt.Sprintf("Ignoring unreadable cache entry for %s: %v", sourceURL, err))
+		entry = nil
+	}
+
+	if offline {
+		if entry == nil {
+			return nil, fmt.Errorf("no cached copy of %s is available", sourceURL)
+		}
+		flags, err := loadFlagsFromData(entry.Manifest)
+		if err != nil {
+			return nil, fmt.Errorf("error loading cached copy of %s: %w", sourceURL, err)
+		}
+		return &PullResult{Flags: flags, Offline: true, FetchedAt: entry.FetchedAt}, nil
+	}
+
+	var cached sync.Validators
+	if entry != nil {
+		cached = sync.Validators{ETag: entry.ETag, LastModified: entry.LastModified}
+	}
+
+	var flags *flagset.Flagset
+	var validators sync.Validators
+	if conditional, ok := backend.(conditionalBackend); ok {
+		flags, validators, err = conditional.PullIfModified(ctx, cached)
+	} else {
+		flags, err = backend.Pull(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PullResult{Flags: flags, FetchedAt: c.now()}
+	if flags == nil {
+		if entry == nil {
+			return nil, fmt.Errorf("%s reported the manifest as not modified, but no cached copy is available", sourceURL)
+		}
+		if result.Flags, err = loadFlagsFromData(entry.Manifest); err != nil {
+			return nil, fmt.Errorf("error loading cached copy of %s: %w", sourceURL, err)
+		}
+		result.NotModified = true
+		// Servers may omit validators from 304 responses, in which case the cached ones still apply
+		if validators == (sync.Validators{}) {
+			validators = cached
+		}
+	}
+
+	if err := c.store(sourceURL, result, validators); err != nil {
+		logger.Default.Debug(fmt.Sprintf("Failed to cache manifest from %s: %v", sourceURL, err))
+	}
+	return result, nil
+}
+
+// path returns the cache file of a source URL. URLs are hashed as they may not be valid file names.
+func (c *Cache) path(sourceURL string) string {
+	return filepath.Join(c.dir, sha256Hex([]byte(sourceURL))+".json")
+}
+
+// load returns the cache entry of a source URL, or nil when there is none
+func (c *Cache) load(sourceURL string) (*cacheEntry, error) {
+	data, err := afero.ReadFile(filesystem.FileSystem(), c.path(sourceURL))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var entry cacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+func (c *Cache) store(sourceURL string, result *PullResult, validators sync.Validators) error {
+	manifest, err := marshalManifest(result.Flags)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(cacheEntry{
+		URL:          sourceURL,
+		ETag:         validators.ETag,
+		LastModified: validators.LastModified,
+		FetchedAt:    result.FetchedAt.UTC(),
+		Manifest:     manifest,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fs := filesystem.FileSystem()
+	if err := fs.MkdirAll(c.dir, 0o755); err != nil {
+		return err
+	}
+	return afero.WriteFile(fs, c.path(sourceURL), data, 0o600)
+}
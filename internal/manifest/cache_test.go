@@ -0,0 +1,111 @@
+package manifest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubBackend is a backend without support for conditional requests
+type stubBackend struct {
+	err error
+}
+
+func (b *stubBackend) Pull(_ context.Context) (*flagset.Flagset, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	return backendTestFlags, nil
+}
+
+func (b *stubBackend) Push(_ context.Context, _ *flagset.Flagset) error {
+	return ErrPushNotSupported
+}
+
+func newTestCache(t *testing.T) *Cache {
+	t.Helper()
+	filesystem.SetFileSystem(afero.NewMemMapFs())
+	t.Cleanup(func() { filesystem.SetFileSystem(afero.NewOsFs()) })
+
+	cache := NewCache("cache")
+	cache.now = func() time.Time { return time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC) }
+	return cache
+}
+
+func TestCachePullConditional(t *testing.T) {
+	cache := newTestCache(t)
+
+	manifest := backendTestManifest
+	var conditionalRequests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		etag := `"` + sha256Hex([]byte(manifest)) + `"`
+		if r.Header.Get("If-None-Match") == etag {
+			conditionalRequests++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		_, _ = w.Write([]byte(manifest))
+	}))
+	t.Cleanup(server.Close)
+
+	sourceURL := server.URL + "/flags.json"
+	backend, err := NewBackend(sourceURL, BackendOptions{})
+	require.NoError(t, err)
+
+	result, err := cache.Pull(context.Background(), sourceURL, backend, false)
+	require.NoError(t, err)
+	assert.False(t, result.NotModified)
+	require.Len(t, result.Flags.Flags, 1)
+
+	result, err = cache.Pull(context.Background(), sourceURL, backend, false)
+	require.NoError(t, err)
+	assert.True(t, result.NotModified)
+	assert.Equal(t, 1, conditionalRequests)
+	require.Len(t, result.Flags.Flags, 1)
+	assert.Equal(t, "search-rollout", result.Flags.Flags[0].Key)
+
+	manifest = `{"flags": {"welcome-banner": {"flagType": "string", "defaultValue": "control"}}}`
+	result, err = cache.Pull(context.Background(), sourceURL, backend, false)
+	require.NoError(t, err)
+	assert.False(t, result.NotModified)
+	require.Len(t, result.Flags.Flags, 1)
+	assert.Equal(t, "welcome-banner", result.Flags.Flags[0].Key)
+
+	fetchedAt, ok := cache.LastFetched(sourceURL)
+	assert.True(t, ok)
+	assert.Equal(t, cache.now(), fetchedAt)
+}
+
+func TestCachePullOffline(t *testing.T) {
+	cache := newTestCache(t)
+
+	_, err := cache.Pull(context.Background(), "s3://bucket/flags.json", &stubBackend{}, true)
+	assert.ErrorContains(t, err, "no cached copy of s3://bucket/flags.json is available")
+
+	result, err := cache.Pull(context.Background(), "s3://bucket/flags.json", &stubBackend{}, false)
+	require.NoError(t, err)
+	assert.False(t, result.Offline)
+
+	// Failed pulls keep the last good copy
+	_, err = cache.Pull(context.Background(), "s3://bucket/flags.json", &stubBackend{err: errors.New("connection reset")}, false)
+	assert.ErrorContains(t, err, "connection reset")
+
+	result, err = cache.Pull(context.Background(), "s3://bucket/flags.json", &stubBackend{err: errors.New("unreachable")}, true)
+	require.NoError(t, err)
+	assert.True(t, result.Offline)
+	assert.Equal(t, cache.now(), result.FetchedAt)
+	assert.Equal(t, backendTestFlags.Flags, result.Flags.Flags)
+
+	_, ok := cache.LastFetched("s3://bucket/other.json")
+	assert.False(t, ok, "entries are keyed by source URL")
+}
@@ -6,25 +6,34 @@ import (
 	"reflect"
 	"slices"
 	"strings"
+
+	"github.com/open-feature/cli/internal/flagset"
 )
 
 type Change struct {
-	Type     string `json:"type"`
-	Path     string `json:"path"`
-	OldValue any    `json:"oldValue,omitempty"`
-	NewValue any    `json:"newValue,omitempty"`
+	Type string `json:"type"`
+	Path string `json:"path"`
+	// OldPath is the path of the flag before a rename
+	OldPath  string   `json:"oldPath,omitempty"`
+	OldValue any      `json:"oldValue,omitempty"`
+	NewValue any      `json:"newValue,omitempty"`
+	Severity Severity `json:"severity"`
 }
 
 // CompareOptions holds options for comparing manifests
 type CompareOptions struct {
 	IgnorePatterns []string
+	// Filter restricts the comparison to flags whose tags pass the filter in each manifest
+	Filter flagset.FilterOptions
+	// DetectRenames reports a removed and an added flag that describe the same flag as a rename
+	DetectRenames bool
 }
 
 // Compare compares two manifests and returns differences, optionally ignoring specified fields
 func Compare(oldManifest, newManifest *Manifest, opts CompareOptions) ([]Change, error) {
 	var changes []Change
-	oldFlags := oldManifest.Flags
-	newFlags := newManifest.Flags
+	oldFlags := filterFlagsByTags(oldManifest.Flags, opts.Filter)
+	newFlags := filterFlagsByTags(newManifest.Flags, opts.Filter)
 
 	// Check for changes and additions
 	for key, newFlag := range newFlags {
@@ -58,9 +67,52 @@ func Compare(oldManifest, newManifest *Manifest, opts CompareOptions) ([]Change,
 		}
 	}
 
+	if opts.DetectRenames {
+		changes = detectRenames(changes, oldFlags, newFlags)
+	}
+
+	for i := range changes {
+		changes[i].Severity = classifyChange(changes[i])
+	}
+
 	return changes, nil
 }
 
+// filterFlagsByTags returns the manifest flags whose tags pass the filter
+func filterFlagsByTags(flags map[string]any, filter flagset.FilterOptions) map[string]any {
+	if len(filter.IncludeTags) == 0 && len(filter.ExcludeTags) == 0 {
+		return flags
+	}
+
+	filtered := make(map[string]any, len(flags))
+	for key, flag := range flags {
+		if filter.MatchesTags(flagTags(flag)) {
+			filtered[key] = flag
+		}
+	}
+	return filtered
+}
+
+// flagTags extracts the tags of a raw manifest flag entry
+func flagTags(flag any) []string {
+	flagMap, ok := flag.(map[string]any)
+	if !ok {
+		return nil
+	}
+	rawTags, ok := flagMap["tags"].([]any)
+	if !ok {
+		return nil
+	}
+
+	tags := make([]string, 0, len(rawTags))
+	for _, tag := range rawTags {
+		if s, ok := tag.(string); ok {
+			tags = append(tags, s)
+		}
+	}
+	return tags
+}
+
 // getKnownFlagProperties returns the set of known schema properties for flags
 // by extracting JSON field names from the BaseFlag struct
 func getKnownFlagProperties() map[string]bool {
@@ -4,6 +4,8 @@ import (
 	"reflect"
 	"sort"
 	"testing"
+
+	"github.com/open-feature/cli/internal/flagset"
 )
 
 func TestCompareDifferentManifests(t *testing.T) {
@@ -49,11 +51,11 @@ func TestCompareDifferentManifests(t *testing.T) {
 		}, NewValue: map[string]any{
 			"flagType":     "string",
 			"defaultValue": "newValue2",
-		}},
+		}, Severity: SeverityPotentiallyBreaking},
 		{Type: "add", Path: "flags.flag3", NewValue: map[string]any{
 			"flagType":     "string",
 			"defaultValue": "value3",
-		}},
+		}, Severity: SeveritySafe},
 	}
 
 	sortChanges(changes)
@@ -282,6 +284,43 @@ func TestCompareFunctionalChanges(t *testing.T) {
 	}
 }
 
+// Test detecting display name changes
+func TestCompareNameChange(t *testing.T) {
+	oldManifest := &Manifest{
+		Flags: map[string]any{
+			"darkMode": map[string]any{
+				"flagType":     "boolean",
+				"defaultValue": false,
+				"name":         "Dark mode",
+			},
+		},
+	}
+
+	newManifest := &Manifest{
+		Flags: map[string]any{
+			"darkMode": map[string]any{
+				"flagType":     "boolean",
+				"defaultValue": false,
+				"name":         "Dark theme",
+			},
+		},
+	}
+
+	changes, err := Compare(oldManifest, newManifest, CompareOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(changes) != 1 {
+		t.Fatalf("expected 1 change for name change, got %d", len(changes))
+	}
+
+	newValue := changes[0].NewValue.(map[string]any)
+	if newValue["name"] != "Dark theme" {
+		t.Errorf("expected new name in change, got %v", newValue["name"])
+	}
+}
+
 // Test detecting flagType changes
 func TestCompareFlagTypeChange(t *testing.T) {
 	oldManifest := &Manifest{
@@ -484,3 +523,68 @@ func TestCompareIgnoreSpecificFlag(t *testing.T) {
 		t.Errorf("expected change in featureX, got %s", changes[0].Path)
 	}
 }
+
+func TestCompareFilterByTags(t *testing.T) {
+	oldManifest := &Manifest{
+		Flags: map[string]any{
+			"webFlag": map[string]any{
+				"flagType":     "boolean",
+				"defaultValue": false,
+				"tags":         []any{"web"},
+			},
+			"backendFlag": map[string]any{
+				"flagType":     "boolean",
+				"defaultValue": false,
+				"tags":         []any{"backend"},
+			},
+		},
+	}
+
+	newManifest := &Manifest{
+		Flags: map[string]any{
+			"webFlag": map[string]any{
+				"flagType":     "boolean",
+				"defaultValue": true,
+				"tags":         []any{"web"},
+			},
+			"backendFlag": map[string]any{
+				"flagType":     "boolean",
+				"defaultValue": true,
+				"tags":         []any{"backend"},
+			},
+			"untaggedFlag": map[string]any{
+				"flagType":     "boolean",
+				"defaultValue": true,
+			},
+		},
+	}
+
+	changes, err := Compare(oldManifest, newManifest, CompareOptions{
+		Filter: flagset.FilterOptions{IncludeTags: []string{"web"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(changes) != 1 {
+		t.Fatalf("expected 1 change, got %d: %v", len(changes), changes)
+	}
+	if changes[0].Path != "flags.webFlag" {
+		t.Errorf("expected change in webFlag, got %s", changes[0].Path)
+	}
+
+	changes, err = Compare(oldManifest, newManifest, CompareOptions{
+		Filter: flagset.FilterOptions{ExcludeTags: []string{"web"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	sortChanges(changes)
+	if len(changes) != 2 {
+		t.Fatalf("expected 2 changes, got %d: %v", len(changes), changes)
+	}
+	if changes[0].Path != "flags.backendFlag" || changes[1].Path != "flags.untaggedFlag" {
+		t.Errorf("unexpected changes: %v", changes)
+	}
+}
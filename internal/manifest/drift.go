@@ -0,0 +1,91 @@
+package manifest
+
+import (
+	"sort"
+	"strings"
+)
+
+// DriftSide describes where a flag changed since the manifest and the provider were last in sync
+type DriftSide string
+
+const (
+	// DriftSideLocal indicates that the flag only changed in the local manifest
+	DriftSideLocal DriftSide = "local"
+	// DriftSideRemote indicates that the flag only changed at the provider
+	DriftSideRemote DriftSide = "remote"
+	// DriftSideBoth indicates that the flag changed on both sides, or that the side cannot be told
+	// because there is no base manifest
+	DriftSideBoth DriftSide = "both"
+)
+
+// DriftedFlag describes a flag that differs between the local manifest and the provider.
+// Local or Remote is nil when the flag does not exist on that side.
+type DriftedFlag struct {
+	Key    string    `json:"key"`
+	Side   DriftSide `json:"side"`
+	Local  any       `json:"local,omitempty"`
+	Remote any       `json:"remote,omitempty"`
+}
+
+// FindDrift returns the flags that differ between the local and remote manifests, sorted by key.
+// The base manifest is the last state both sides agreed on, e.g. the last pulled copy, and tells
+// which side changed a flag. Without a base, flags that exist on one side only are attributed to
+// that side, and flags that exist on both sides with different values to both.
+func FindDrift(local, remote, base *Manifest, opts CompareOptions) ([]DriftedFlag, error) {
+	changes, err := Compare(remote, local, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var localChanged, remoteChanged map[string]bool
+	if base != nil {
+		if localChanged, err = changedFlags(base, local, opts); err != nil {
+			return nil, err
+		}
+		if remoteChanged, err = changedFlags(base, remote, opts); err != nil {
+			return nil, err
+		}
+	}
+
+	drift := make([]DriftedFlag, 0, len(changes))
+	for _, change := range changes {
+		flag := DriftedFlag{
+			Key:    strings.TrimPrefix(change.Path, "flags."),
+			Local:  change.NewValue,
+			Remote: change.OldValue,
+		}
+
+		switch {
+		case base != nil && localChanged[flag.Key] && !remoteChanged[flag.Key]:
+			flag.Side = DriftSideLocal
+		case base != nil && remoteChanged[flag.Key] && !localChanged[flag.Key]:
+			flag.Side = DriftSideRemote
+		case base == nil && change.Type == "add":
+			flag.Side = DriftSideLocal
+		case base == nil && change.Type == "remove":
+			flag.Side = DriftSideRemote
+		default:
+			flag.Side = DriftSideBoth
+		}
+		drift = append(drift, flag)
+	}
+
+	sort.Slice(drift, func(i, j int) bool {
+		return drift[i].Key < drift[j].Key
+	})
+	return drift, nil
+}
+
+// changedFlags returns the keys of the flags that differ between two manifests
+func changedFlags(oldManifest, newManifest *Manifest, opts CompareOptions) (map[string]bool, error) {
+	changes, err := Compare(oldManifest, newManifest, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]bool, len(changes))
+	for _, change := range changes {
+		keys[strings.TrimPrefix(change.Path, "flags.")] = true
+	}
+	return keys, nil
+}
@@ -0,0 +1,101 @@
+package manifest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func driftTestManifest(flags map[string]string) *Manifest {
+	m := &Manifest{Flags: make(map[string]any)}
+	for key, defaultValue := range flags {
+		m.Flags[key] = map[string]any{"flagType": "string", "defaultValue": defaultValue}
+	}
+	return m
+}
+
+func TestFindDrift(t *testing.T) {
+	base := driftTestManifest(map[string]string{
+		"unchanged":      "a",
+		"local-edit":     "a",
+		"remote-edit":    "a",
+		"both-edit":      "a",
+		"local-delete":   "a",
+		"remote-delete":  "a",
+		"same-both-edit": "a",
+	})
+	local := driftTestManifest(map[string]string{
+		"unchanged":      "a",
+		"local-edit":     "b",
+		"remote-edit":    "a",
+		"both-edit":      "b",
+		"remote-delete":  "a",
+		"same-both-edit": "b",
+		"local-add":      "a",
+	})
+	remote := driftTestManifest(map[string]string{
+		"unchanged":      "a",
+		"local-edit":     "a",
+		"remote-edit":    "b",
+		"both-edit":      "c",
+		"local-delete":   "a",
+		"same-both-edit": "b",
+		"remote-add":     "a",
+	})
+
+	t.Run("with a base, each flag is attributed to the side it changed on", func(t *testing.T) {
+		drift, err := FindDrift(local, remote, base, CompareOptions{})
+		require.NoError(t, err)
+
+		sides := make(map[string]DriftSide)
+		for _, flag := range drift {
+			sides[flag.Key] = flag.Side
+		}
+		assert.Equal(t, map[string]DriftSide{
+			"both-edit":     DriftSideBoth,
+			"local-add":     DriftSideLocal,
+			"local-delete":  DriftSideLocal,
+			"local-edit":    DriftSideLocal,
+			"remote-add":    DriftSideRemote,
+			"remote-delete": DriftSideRemote,
+			"remote-edit":   DriftSideRemote,
+		}, sides)
+		assert.Equal(t, "both-edit", drift[0].Key, "flags are sorted by key")
+	})
+
+	t.Run("without a base, differing flags are attributed to both sides", func(t *testing.T) {
+		drift, err := FindDrift(local, remote, nil, CompareOptions{})
+		require.NoError(t, err)
+
+		sides := make(map[string]DriftSide)
+		for _, flag := range drift {
+			sides[flag.Key] = flag.Side
+		}
+		assert.Equal(t, DriftSideLocal, sides["local-add"])
+		assert.Equal(t, DriftSideRemote, sides["local-delete"])
+		assert.Equal(t, DriftSideBoth, sides["local-edit"])
+	})
+
+	t.Run("values of each side are reported", func(t *testing.T) {
+		drift, err := FindDrift(local, remote, base, CompareOptions{})
+		require.NoError(t, err)
+
+		for _, flag := range drift {
+			switch flag.Key {
+			case "remote-edit":
+				assert.Equal(t, map[string]any{"flagType": "string", "defaultValue": "a"}, flag.Local)
+				assert.Equal(t, map[string]any{"flagType": "string", "defaultValue": "b"}, flag.Remote)
+			case "remote-add":
+				assert.Nil(t, flag.Local)
+				assert.NotNil(t, flag.Remote)
+			}
+		}
+	})
+
+	t.Run("no drift", func(t *testing.T) {
+		drift, err := FindDrift(local, local, base, CompareOptions{})
+		require.NoError(t, err)
+		assert.Empty(t, drift)
+	})
+}
@@ -0,0 +1,94 @@
+package manifest
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/open-feature/cli/internal/flagset"
+)
+
+// ExportFormat is a provider-specific flag configuration format a manifest can be converted into
+type ExportFormat string
+
+const (
+	// ExportFormatFlagd is the flagd JSON flag definition format
+	ExportFormatFlagd ExportFormat = "flagd"
+)
+
+// flagdSchema is the schema referenced by exported flagd flag definitions
+const flagdSchema = "https://flagd.dev/schema/v0/flags.json"
+
+// flagdTypeMetadataKey is the flagd metadata entry recording the manifest flag type.
+// flagd variants do not distinguish floats with integral values from integers, so the type is kept for import.
+const flagdTypeMetadataKey = "flagType"
+
+// GetValidExportFormats returns the export formats as a list of strings
+func GetValidExportFormats() []string {
+	return []string{
+		string(ExportFormatFlagd),
+	}
+}
+
+type flagdFlag struct {
+	State          string         `json:"state"`
+	Variants       map[string]any `json:"variants"`
+	DefaultVariant string         `json:"defaultVariant"`
+	Metadata       map[string]any `json:"metadata,omitempty"`
+}
+
+// Export converts the flagset into the given provider-specific configuration format
+func Export(format ExportFormat, fs *flagset.Flagset) ([]byte, error) {
+	switch format {
+	case ExportFormatFlagd:
+		return exportFlagd(fs)
+	default:
+		return nil, fmt.Errorf("unsupported export format: %s. Valid formats are: %s",
+			format, strings.Join(GetValidExportFormats(), ", "))
+	}
+}
+
+// exportFlagd converts each flag into an enabled flagd flag that serves the flag's default value.
+// Boolean flags get on and off variants; other flags get a single default variant.
+func exportFlagd(fs *flagset.Flagset) ([]byte, error) {
+	flags := make(map[string]flagdFlag, len(fs.Flags))
+	for _, flag := range fs.Flags {
+		flagdDef := flagdFlag{State: "ENABLED"}
+
+		switch flag.Type {
+		case flagset.BoolType:
+			defaultValue, ok := flag.DefaultValue.(bool)
+			if !ok {
+				return nil, fmt.Errorf("flag %q: default value %v is not a boolean", flag.Key, flag.DefaultValue)
+			}
+			flagdDef.Variants = map[string]any{"on": true, "off": false}
+			flagdDef.DefaultVariant = "off"
+			if defaultValue {
+				flagdDef.DefaultVariant = "on"
+			}
+		case flagset.IntType, flagset.FloatType, flagset.StringType, flagset.ObjectType:
+			flagdDef.Variants = map[string]any{"default": flag.DefaultValue}
+			flagdDef.DefaultVariant = "default"
+		default:
+			return nil, fmt.Errorf("flag %q has unsupported type %s", flag.Key, flag.Type)
+		}
+
+		metadata := make(map[string]any)
+		if flag.Description != "" {
+			metadata["description"] = flag.Description
+		}
+		if flag.Type == flagset.FloatType {
+			metadata[flagdTypeMetadataKey] = flag.Type.String()
+		}
+		if len(metadata) > 0 {
+			flagdDef.Metadata = metadata
+		}
+
+		flags[flag.Key] = flagdDef
+	}
+
+	return json.MarshalIndent(map[string]any{
+		"$schema": flagdSchema,
+		"flags":   flags,
+	}, "", "  ")
+}
@@ -0,0 +1,72 @@
+package manifest
+
+import (
+	"testing"
+
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportFlagd(t *testing.T) {
+	fs := &flagset.Flagset{Flags: []flagset.Flag{
+		{Key: "show-banner", Type: flagset.BoolType, DefaultValue: true, Description: "Show the promotional banner"},
+		{Key: "max-items", Type: flagset.IntType, DefaultValue: 50},
+	}}
+
+	data, err := Export(ExportFormatFlagd, fs)
+	require.NoError(t, err)
+
+	expected := `{
+		"$schema": "https://flagd.dev/schema/v0/flags.json",
+		"flags": {
+			"show-banner": {
+				"state": "ENABLED",
+				"variants": {"on": true, "off": false},
+				"defaultVariant": "on",
+				"metadata": {"description": "Show the promotional banner"}
+			},
+			"max-items": {
+				"state": "ENABLED",
+				"variants": {"default": 50},
+				"defaultVariant": "default"
+			}
+		}
+	}`
+	assert.JSONEq(t, expected, string(data))
+}
+
+func TestExportFlagdRoundTrip(t *testing.T) {
+	original := &flagset.Flagset{Flags: []flagset.Flag{
+		{Key: "config", Type: flagset.ObjectType, DefaultValue: map[string]any{"retries": float64(3), "mode": "fast"}},
+		{Key: "disabled-feature", Type: flagset.BoolType, DefaultValue: false},
+		{Key: "discount", Type: flagset.FloatType, DefaultValue: 0.15},
+		{Key: "greeting", Type: flagset.StringType, DefaultValue: "Hello!", Description: "Greeting shown to users"},
+		{Key: "max-items", Type: flagset.IntType, DefaultValue: 50},
+		{Key: "ratio", Type: flagset.FloatType, DefaultValue: float64(2)},
+	}}
+
+	data, err := Export(ExportFormatFlagd, original)
+	require.NoError(t, err)
+
+	result, err := Import(ImportFormatFlagd, data)
+	require.NoError(t, err)
+	assert.Empty(t, result.Warnings)
+	assert.Equal(t, original.Flags, result.Flagset.Flags)
+}
+
+func TestExportUnsupportedFormat(t *testing.T) {
+	_, err := Export(ExportFormat("unleash"), &flagset.Flagset{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported export format: unleash")
+}
+
+func TestExportFlagdInvalidDefault(t *testing.T) {
+	fs := &flagset.Flagset{Flags: []flagset.Flag{
+		{Key: "broken", Type: flagset.BoolType, DefaultValue: "yes"},
+	}}
+
+	_, err := Export(ExportFormatFlagd, fs)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `flag "broken"`)
+}
@@ -0,0 +1,265 @@
+package manifest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/open-feature/cli/internal/api/sync"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/logger"
+	"github.com/open-feature/cli/internal/transport"
+	"github.com/spf13/afero"
+)
+
+// LoadOptions holds options for loading manifests
+type LoadOptions struct {
+	// HTTPClient returns the client fetching a remote manifest, e.g. one extended by a local manifest,
+	// so that the TLS and proxy settings of its host apply. When nil, the default transport is used.
+	HTTPClient func(rawURL string) (*http.Client, error)
+}
+
+// httpClient returns the client for requests to the URL
+func (o LoadOptions) httpClient(rawURL string) (*http.Client, error) {
+	if o.HTTPClient != nil {
+		return o.HTTPClient(rawURL)
+	}
+	return transport.NewClient(transport.Options{}, sync.DefaultTimeout)
+}
+
+// LoadManifest loads the manifest at the given location and resolves the manifests it extends.
+// The location may be a local path, a directory or glob of manifest fragments, or an http(s) URL.
+// The returned manifest contains the composed set of flags and no extends references.
+func LoadManifest(location string, opts LoadOptions) (*Manifest, error) {
+	if isRemoteLocation(location) || !IsComposite(location) {
+		return loadComposedManifest(location, opts)
+	}
+
+	paths, err := ResolveManifestPaths(location)
+	if err != nil {
+		return nil, err
+	}
+
+	// As in LoadFlagSources, only flags defined by more than one fragment are duplicates
+	sources := make(map[string]string)
+	inherited := make(map[string]any)
+	merged := &Manifest{Flags: make(map[string]any)}
+	for _, path := range paths {
+		m, err := loadComposedManifest(path, opts)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		local, err := localFlagKeys(path)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		for key, flag := range m.Flags {
+			if !local[key] {
+				inherited[key] = flag
+				continue
+			}
+			if existing, ok := sources[key]; ok {
+				return nil, fmt.Errorf("flag %q is defined in both %s and %s", key, existing, path)
+			}
+			sources[key] = path
+			merged.Flags[key] = flag
+		}
+	}
+
+	for key, flag := range inherited {
+		if _, ok := merged.Flags[key]; !ok {
+			merged.Flags[key] = flag
+		}
+	}
+
+	return merged, nil
+}
+
+// localFlagKeys returns the keys of the flags defined in the manifest file itself, excluding the
+// flags it inherits through extends
+func localFlagKeys(path string) (map[string]bool, error) {
+	data, err := readManifestLocation(path, LoadOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var m struct {
+		Flags map[string]json.RawMessage `json:"flags"`
+	}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("error unmarshaling JSON from %s: %v", path, err)
+	}
+
+	keys := make(map[string]bool, len(m.Flags))
+	for key := range m.Flags {
+		keys[key] = true
+	}
+	return keys, nil
+}
+
+// loadComposedManifest loads a single manifest and merges in the flags of the manifests it extends
+func loadComposedManifest(location string, opts LoadOptions) (*Manifest, error) {
+	location = normalizeLocation(location)
+	data, err := readManifestLocation(location, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	flags, err := composeFlags(data, location, []string{location}, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Manifest{Flags: flags}, nil
+}
+
+// resolveExtends returns the manifest data with the flags of all extended manifests merged in.
+// Data without an extends property is returned unchanged.
+func resolveExtends(data []byte, location string, opts LoadOptions) ([]byte, error) {
+	var m Manifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("error unmarshaling JSON: %v", err)
+	}
+	if len(m.Extends) == 0 {
+		return data, nil
+	}
+
+	location = normalizeLocation(location)
+	flags, err := composeFlags(data, location, []string{location}, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(Manifest{Flags: flags})
+}
+
+// composeFlags merges the flags of the extended manifests, in order, with the flags of the manifest itself.
+// Later manifests override earlier ones and the manifest's own flags override everything it inherits.
+// The chain holds the locations currently being resolved and is used to detect cycles.
+func composeFlags(data []byte, location string, chain []string, opts LoadOptions) (map[string]any, error) {
+	var m Manifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("error unmarshaling JSON from %s: %v", location, err)
+	}
+
+	flags := make(map[string]any)
+	for _, ref := range m.Extends {
+		refLocation, err := resolveManifestReference(location, ref)
+		if err != nil {
+			return nil, err
+		}
+		if slices.Contains(chain, refLocation) {
+			return nil, fmt.Errorf("manifest extends cycle detected: %s -> %s", strings.Join(chain, " -> "), refLocation)
+		}
+
+		refData, err := readManifestLocation(refLocation, opts)
+		if err != nil {
+			return nil, fmt.Errorf("error loading manifest %s extended by %s: %w", refLocation, location, err)
+		}
+
+		validationErrors, err := Validate(refData)
+		if err != nil {
+			return nil, err
+		} else if len(validationErrors) > 0 {
+			return nil, fmt.Errorf("%s: %w", refLocation, errors.New(FormatValidationError(validationErrors)))
+		}
+
+		inherited, err := composeFlags(refData, refLocation, append(slices.Clone(chain), refLocation), opts)
+		if err != nil {
+			return nil, err
+		}
+		for key, flag := range inherited {
+			flags[key] = flag
+		}
+	}
+
+	for key, flag := range m.Flags {
+		flags[key] = flag
+	}
+
+	return flags, nil
+}
+
+// resolveManifestReference resolves an extends reference relative to the manifest that contains it
+func resolveManifestReference(location, ref string) (string, error) {
+	refURL, err := url.Parse(ref)
+	if err != nil {
+		return "", fmt.Errorf("invalid extends reference %q in %s: %w", ref, location, err)
+	}
+
+	switch refURL.Scheme {
+	case "https", "http":
+		return ref, nil
+	case "file":
+		return filepath.Clean(filepath.Join(refURL.Host, refURL.Path)), nil
+	case "":
+		// Relative references are resolved against the referencing manifest
+	default:
+		return "", fmt.Errorf("unsupported extends reference %q in %s. Supported schemes are file://, http:// and https://", ref, location)
+	}
+
+	if isRemoteLocation(location) {
+		base, err := url.Parse(location)
+		if err != nil {
+			return "", err
+		}
+		return base.ResolveReference(refURL).String(), nil
+	}
+
+	if filepath.IsAbs(ref) {
+		return filepath.Clean(ref), nil
+	}
+	return filepath.Join(filepath.Dir(location), ref), nil
+}
+
+// readManifestLocation reads a manifest from a local path or an http(s) URL
+func readManifestLocation(location string, opts LoadOptions) ([]byte, error) {
+	if !isRemoteLocation(location) {
+		data, err := afero.ReadFile(filesystem.FileSystem(), location)
+		if err != nil {
+			return nil, fmt.Errorf("error reading contents from file %q", location)
+		}
+		return data, nil
+	}
+
+	logger.Default.Debug(fmt.Sprintf("Fetching extended manifest from %s", location))
+
+	client, err := opts.httpClient(location)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transport settings: %w", err)
+	}
+	resp, err := client.Get(location)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("received error response %d from %s", resp.StatusCode, location)
+	}
+
+	return body, nil
+}
+
+// normalizeLocation cleans local paths so that cycles are detected regardless of how a path is spelled
+func normalizeLocation(location string) string {
+	if isRemoteLocation(location) {
+		return location
+	}
+	return filepath.Clean(location)
+}
+
+func isRemoteLocation(location string) bool {
+	return strings.HasPrefix(location, "https://") || strings.HasPrefix(location, "http://")
+}
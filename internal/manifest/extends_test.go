@@ -0,0 +1,189 @@
+package manifest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/h2non/gock"
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadFlagSetWithExtends(t *testing.T) {
+	setupFragments(t, map[string]string{
+		"/platform/flags.json": `{
+			"flags": {
+				"maintenance-mode": {"flagType": "boolean", "defaultValue": false, "description": "Platform maintenance"},
+				"request-timeout": {"flagType": "integer", "defaultValue": 30}
+			}
+		}`,
+		"/product/flags.json": `{
+			"extends": ["../platform/flags.json"],
+			"flags": {
+				"request-timeout": {"flagType": "integer", "defaultValue": 60},
+				"checkout-v2": {"flagType": "boolean", "defaultValue": true}
+			}
+		}`,
+	})
+
+	fs, err := LoadFlagSet("/product/flags.json", LoadOptions{})
+	require.NoError(t, err)
+
+	expected := []flagset.Flag{
+		{Key: "checkout-v2", Type: flagset.BoolType, DefaultValue: true},
+		{Key: "maintenance-mode", Type: flagset.BoolType, DefaultValue: false, Description: "Platform maintenance"},
+		{Key: "request-timeout", Type: flagset.IntType, DefaultValue: float64(60)},
+	}
+	assert.Equal(t, expected, fs.Flags)
+
+	local, err := LoadLocalFlagSet("/product/flags.json")
+	require.NoError(t, err)
+	assert.Len(t, local.Flags, 2, "local flag set should not include inherited flags")
+}
+
+func TestLoadFlagSetWithNestedExtends(t *testing.T) {
+	setupFragments(t, map[string]string{
+		"/base.json":   `{"flags": {"a": {"flagType": "boolean", "defaultValue": false}}}`,
+		"/middle.json": `{"extends": ["file:///base.json"], "flags": {"b": {"flagType": "boolean", "defaultValue": false}}}`,
+		"/top.json":    `{"extends": ["middle.json"], "flags": {"c": {"flagType": "boolean", "defaultValue": false}}}`,
+	})
+
+	fs, err := LoadFlagSet("/top.json", LoadOptions{})
+	require.NoError(t, err)
+	require.Len(t, fs.Flags, 3)
+	assert.Equal(t, "a", fs.Flags[0].Key)
+	assert.Equal(t, "b", fs.Flags[1].Key)
+	assert.Equal(t, "c", fs.Flags[2].Key)
+}
+
+func TestLoadFlagSetExtendsCycle(t *testing.T) {
+	setupFragments(t, map[string]string{
+		"/a.json": `{"extends": ["b.json"], "flags": {}}`,
+		"/b.json": `{"extends": ["./a.json"], "flags": {}}`,
+	})
+
+	_, err := LoadFlagSet("/a.json", LoadOptions{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "manifest extends cycle detected: /a.json -> /b.json -> /a.json")
+}
+
+func TestLoadFlagSetExtendsMissingManifest(t *testing.T) {
+	setupFragments(t, map[string]string{
+		"/a.json": `{"extends": ["missing.json"], "flags": {}}`,
+	})
+
+	_, err := LoadFlagSet("/a.json", LoadOptions{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "/missing.json extended by /a.json")
+}
+
+func TestLoadFlagSetExtendsRemote(t *testing.T) {
+	setupFragments(t, map[string]string{
+		"/flags.json": `{"extends": ["https://example.com/shared/platform.json"], "flags": {}}`,
+	})
+	defer gock.Off()
+
+	gock.New("https://example.com").
+		Get("/shared/platform.json").
+		Reply(200).
+		JSON(map[string]any{
+			"extends": []string{"core.json"},
+			"flags": map[string]any{
+				"maintenance-mode": map[string]any{"flagType": "boolean", "defaultValue": false},
+			},
+		})
+	gock.New("https://example.com").
+		Get("/shared/core.json").
+		Reply(200).
+		JSON(map[string]any{
+			"flags": map[string]any{
+				"core-flag": map[string]any{"flagType": "string", "defaultValue": "on"},
+			},
+		})
+
+	fs, err := LoadFlagSet("/flags.json", LoadOptions{})
+	require.NoError(t, err)
+	require.Len(t, fs.Flags, 2)
+	assert.Equal(t, "core-flag", fs.Flags[0].Key)
+	assert.Equal(t, "maintenance-mode", fs.Flags[1].Key)
+	assert.True(t, gock.IsDone())
+}
+
+func TestLoadFlagSetExtendsRemoteUsesHTTPClient(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"flags": {"maintenance-mode": {"flagType": "boolean", "defaultValue": false}}}`))
+	}))
+	t.Cleanup(server.Close)
+	setupFragments(t, map[string]string{
+		"/flags.json": `{"extends": ["` + server.URL + `/platform.json"], "flags": {}}`,
+	})
+
+	// The server's certificate is not trusted by the default transport
+	_, err := LoadFlagSet("/flags.json", LoadOptions{})
+	assert.ErrorContains(t, err, "certificate")
+
+	var requested []string
+	fs, err := LoadFlagSet("/flags.json", LoadOptions{HTTPClient: func(rawURL string) (*http.Client, error) {
+		requested = append(requested, rawURL)
+		return server.Client(), nil
+	}})
+	require.NoError(t, err)
+	require.Len(t, fs.Flags, 1)
+	assert.Equal(t, []string{server.URL + "/platform.json"}, requested)
+}
+
+func TestLoadManifestWithExtends(t *testing.T) {
+	setupFragments(t, map[string]string{
+		"/base.json": `{"flags": {"a": {"flagType": "boolean", "defaultValue": false}}}`,
+		"/top.json":  `{"extends": ["base.json"], "flags": {"b": {"flagType": "boolean", "defaultValue": true}}}`,
+	})
+
+	m, err := LoadManifest("/top.json", LoadOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, m.Extends)
+	assert.Contains(t, m.Flags, "a")
+	assert.Contains(t, m.Flags, "b")
+}
+
+func TestWritePreservesExtends(t *testing.T) {
+	memFs := setupFragments(t, map[string]string{
+		"/base.json": `{"flags": {"a": {"flagType": "boolean", "defaultValue": false}}}`,
+		"/top.json":  `{"extends": ["base.json"], "flags": {}}`,
+	})
+
+	fs, err := LoadLocalFlagSet("/top.json")
+	require.NoError(t, err)
+	fs.Flags = append(fs.Flags, flagset.Flag{Key: "b", Type: flagset.BoolType, DefaultValue: true})
+	require.NoError(t, WriteLocal("/top.json", *fs))
+
+	data, err := afero.ReadFile(memFs, "/top.json")
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"extends"`)
+	assert.NotContains(t, string(data), `"a"`, "inherited flags should not be written to the extending manifest")
+}
+
+func TestWriteDropsExtends(t *testing.T) {
+	memFs := setupFragments(t, map[string]string{
+		"/base.json": `{"flags": {"a": {"flagType": "boolean", "defaultValue": false}}}`,
+		"/top.json":  `{"extends": ["base.json"], "flags": {"b": {"flagType": "boolean", "defaultValue": true}}}`,
+	})
+
+	// A pulled flag set is complete, so keeping extends would inherit flags the remote no longer has
+	fs, err := LoadFlagSet("/top.json", LoadOptions{})
+	require.NoError(t, err)
+	fs.Flags = fs.Flags[1:]
+	assert.False(t, IsUpToDate("/top.json", *fs))
+	require.NoError(t, Write("/top.json", *fs))
+	assert.True(t, IsUpToDate("/top.json", *fs))
+
+	data, err := afero.ReadFile(memFs, "/top.json")
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), `"extends"`)
+
+	written, err := LoadFlagSet("/top.json", LoadOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, fs.Flags, written.Flags)
+}
@@ -0,0 +1,158 @@
+package manifest
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/spf13/afero"
+)
+
+// manifestFragmentExt is the extension of files picked up when a directory of fragments is loaded
+const manifestFragmentExt = ".json"
+
+// IsComposite reports whether the manifest path refers to a directory or glob of manifest fragments
+// rather than a single manifest file.
+func IsComposite(manifestPath string) bool {
+	if isGlobPattern(manifestPath) {
+		return true
+	}
+	isDir, err := afero.IsDir(filesystem.FileSystem(), manifestPath)
+	return err == nil && isDir
+}
+
+// ResolveManifestPaths returns the manifest files referenced by the given path in a stable order.
+// A directory resolves to every .json file beneath it and a glob pattern to the files it matches.
+// Any other path is returned as is.
+func ResolveManifestPaths(manifestPath string) ([]string, error) {
+	fs := filesystem.FileSystem()
+
+	if isGlobPattern(manifestPath) {
+		matches, err := afero.Glob(fs, manifestPath)
+		if err != nil {
+			return nil, fmt.Errorf("invalid manifest pattern %q: %w", manifestPath, err)
+		}
+		var paths []string
+		for _, match := range matches {
+			if isDir, _ := afero.IsDir(fs, match); !isDir {
+				paths = append(paths, match)
+			}
+		}
+		if len(paths) == 0 {
+			return nil, fmt.Errorf("no manifest files match %q", manifestPath)
+		}
+		sort.Strings(paths)
+		return paths, nil
+	}
+
+	isDir, err := afero.IsDir(fs, manifestPath)
+	if err != nil || !isDir {
+		return []string{manifestPath}, nil
+	}
+
+	var paths []string
+	err = afero.Walk(fs, manifestPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() && filepath.Ext(path) == manifestFragmentExt {
+			paths = append(paths, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading manifest directory %q: %w", manifestPath, err)
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no manifest files found in directory %q", manifestPath)
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+// ContainsFragment reports whether the fragment path would be picked up when loading the manifest path.
+// This is used to make sure new flags are not written to a file outside of the composed manifest.
+func ContainsFragment(manifestPath, fragmentPath string) bool {
+	if isGlobPattern(manifestPath) {
+		matched, err := filepath.Match(filepath.Clean(manifestPath), filepath.Clean(fragmentPath))
+		return err == nil && matched
+	}
+
+	if filepath.Ext(fragmentPath) != manifestFragmentExt {
+		return false
+	}
+	rel, err := filepath.Rel(manifestPath, fragmentPath)
+	return err == nil && rel != "." && !strings.HasPrefix(rel, "..")
+}
+
+// LoadFlagSources loads the manifest at the given path, merging fragments when the path is a directory or glob.
+// It also returns the file that defines each flag key; flags that are only inherited through extends have no
+// source. Keys defined in more than one file are reported as an error, while fragments may inherit the same
+// flags, e.g. by extending the same base manifest.
+func LoadFlagSources(manifestPath string, opts LoadOptions) (*flagset.Flagset, map[string]string, error) {
+	paths, err := ResolveManifestPaths(manifestPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sources := make(map[string]string)
+	inherited := make(map[string]flagset.Flag)
+	merged := &flagset.Flagset{Flags: []flagset.Flag{}}
+	for _, path := range paths {
+		fs, local, err := loadFragment(path, opts)
+		if err != nil {
+			if len(paths) > 1 {
+				return nil, nil, fmt.Errorf("%s: %w", path, err)
+			}
+			return nil, nil, err
+		}
+
+		for _, flag := range fs.Flags {
+			if !local[flag.Key] {
+				// Later fragments override what earlier ones inherit, like later extended manifests do
+				inherited[flag.Key] = flag
+				continue
+			}
+			if existing, ok := sources[flag.Key]; ok {
+				return nil, nil, fmt.Errorf("flag %q is defined in both %s and %s", flag.Key, existing, path)
+			}
+			sources[flag.Key] = path
+			merged.Flags = append(merged.Flags, flag)
+		}
+	}
+
+	// Flags defined in any fragment override inherited ones
+	for key, flag := range inherited {
+		if _, ok := sources[key]; !ok {
+			merged.Flags = append(merged.Flags, flag)
+		}
+	}
+
+	sort.Slice(merged.Flags, func(i, j int) bool {
+		return merged.Flags[i].Key < merged.Flags[j].Key
+	})
+
+	return merged, sources, nil
+}
+
+// loadFragment loads a manifest file with the flags it inherits, and returns the keys of the flags it
+// defines itself
+func loadFragment(path string, opts LoadOptions) (*flagset.Flagset, map[string]bool, error) {
+	fs, err := loadFlagSetFile(path, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	local, err := localFlagKeys(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	return fs, local, nil
+}
+
+func isGlobPattern(path string) bool {
+	return strings.ContainsAny(path, "*?[")
+}
@@ -0,0 +1,131 @@
+package manifest
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupFragments(t *testing.T, files map[string]string) afero.Fs {
+	t.Helper()
+	memFs := afero.NewMemMapFs()
+	filesystem.SetFileSystem(memFs)
+	t.Cleanup(func() { filesystem.SetFileSystem(afero.NewOsFs()) })
+
+	for path, content := range files {
+		require.NoError(t, afero.WriteFile(memFs, path, []byte(content), 0o644))
+	}
+	return memFs
+}
+
+func TestLoadFlagSetFromFragments(t *testing.T) {
+	setupFragments(t, map[string]string{
+		"/flags/payments/flags.json": `{"flags": {"checkout-v2": {"flagType": "boolean", "defaultValue": false}}}`,
+		"/flags/search/flags.json":   `{"flags": {"search-limit": {"flagType": "integer", "defaultValue": 10}}}`,
+		"/flags/search/README.md":    `not a manifest`,
+	})
+
+	tests := []struct {
+		name         string
+		manifestPath string
+	}{
+		{name: "directory", manifestPath: "/flags"},
+		{name: "glob", manifestPath: "/flags/*/flags.json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.True(t, IsComposite(tt.manifestPath))
+
+			fs, sources, err := LoadFlagSources(tt.manifestPath, LoadOptions{})
+			require.NoError(t, err)
+			require.Len(t, fs.Flags, 2)
+			assert.Equal(t, "checkout-v2", fs.Flags[0].Key)
+			assert.Equal(t, "search-limit", fs.Flags[1].Key)
+			assert.Equal(t, "/flags/payments/flags.json", sources["checkout-v2"])
+			assert.Equal(t, "/flags/search/flags.json", sources["search-limit"])
+		})
+	}
+}
+
+func TestLoadFlagSetDuplicateKeyAcrossFragments(t *testing.T) {
+	setupFragments(t, map[string]string{
+		"/flags/a.json": `{"flags": {"shared": {"flagType": "boolean", "defaultValue": false}}}`,
+		"/flags/b.json": `{"flags": {"shared": {"flagType": "boolean", "defaultValue": true}}}`,
+	})
+
+	_, err := LoadFlagSet("/flags", LoadOptions{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `flag "shared" is defined in both /flags/a.json and /flags/b.json`)
+}
+
+func TestLoadFragmentsExtendingTheSameBase(t *testing.T) {
+	setupFragments(t, map[string]string{
+		"/base.json":    `{"flags": {"platform-flag": {"flagType": "boolean", "defaultValue": false}, "theme": {"flagType": "string", "defaultValue": "light"}}}`,
+		"/frags/a.json": `{"extends": ["../base.json"], "flags": {"checkout-v2": {"flagType": "boolean", "defaultValue": false}}}`,
+		"/frags/b.json": `{"extends": ["../base.json"], "flags": {"theme": {"flagType": "string", "defaultValue": "dark"}}}`,
+	})
+
+	fs, sources, err := LoadFlagSources("/frags", LoadOptions{})
+	require.NoError(t, err)
+
+	defaults := make(map[string]any)
+	for _, flag := range fs.Flags {
+		defaults[flag.Key] = flag.DefaultValue
+	}
+	// The flag defined in b.json overrides the one a.json inherits
+	assert.Equal(t, map[string]any{"checkout-v2": false, "platform-flag": false, "theme": "dark"}, defaults)
+	// Inherited flags have no source, so they are not deleted from or reported as defined in a fragment
+	assert.Equal(t, map[string]string{"checkout-v2": "/frags/a.json", "theme": "/frags/b.json"}, sources)
+
+	m, err := LoadManifest("/frags", LoadOptions{})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"checkout-v2", "platform-flag", "theme"}, slices.Collect(maps.Keys(m.Flags)))
+	assert.Equal(t, "dark", m.Flags["theme"].(map[string]any)["defaultValue"])
+}
+
+func TestLoadFlagSetInvalidFragmentNamesFile(t *testing.T) {
+	setupFragments(t, map[string]string{
+		"/flags/a.json": `{"flags": {"valid": {"flagType": "boolean", "defaultValue": false}}}`,
+		"/flags/b.json": `{"flags": {"invalid": {"defaultValue": true}}}`,
+	})
+
+	_, err := LoadFlagSet("/flags", LoadOptions{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "/flags/b.json")
+}
+
+func TestLoadFlagSetGlobWithoutMatches(t *testing.T) {
+	setupFragments(t, map[string]string{})
+
+	_, err := LoadFlagSet("/flags/*.json", LoadOptions{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no manifest files match")
+}
+
+func TestContainsFragment(t *testing.T) {
+	tests := []struct {
+		name         string
+		manifestPath string
+		fragmentPath string
+		expected     bool
+	}{
+		{name: "file in directory", manifestPath: "flags", fragmentPath: "flags/team.json", expected: true},
+		{name: "file in nested directory", manifestPath: "flags", fragmentPath: "flags/team/flags.json", expected: true},
+		{name: "file outside directory", manifestPath: "flags", fragmentPath: "other/team.json", expected: false},
+		{name: "non-json file in directory", manifestPath: "flags", fragmentPath: "flags/team.yaml", expected: false},
+		{name: "file matching glob", manifestPath: "flags/*.json", fragmentPath: "flags/team.json", expected: true},
+		{name: "file not matching glob", manifestPath: "flags/*.json", fragmentPath: "flags/team/flags.json", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ContainsFragment(tt.manifestPath, tt.fragmentPath))
+		})
+	}
+}
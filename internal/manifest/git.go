@@ -0,0 +1,262 @@
+package manifest
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/open-feature/cli/internal/logger"
+	"github.com/open-feature/cli/internal/transport"
+)
+
+const (
+	// defaultGitManifestPath is the path of the manifest within the repository when the URL has no fragment
+	defaultGitManifestPath = "flags.json"
+	// gitCommitMessage is used for the commits created by Push
+	gitCommitMessage = "Update feature flag manifest"
+)
+
+// gitBackend stores a manifest in a Git repository, using the git executable.
+// URLs have the form git+https://host/org/repo.git?ref=main#path/to/flags.json, with the
+// git+ssh and git+file schemes also supported. Without a ref the default branch of the
+// repository is used, and without a path the manifest is read from flags.json.
+type gitBackend struct {
+	// repository is the URL passed to git, without the git+ prefix, the query and the fragment
+	repository string
+	// ref is a branch, tag or commit SHA
+	ref string
+	// path is the slash-separated path of the manifest within the repository
+	path      string
+	authToken string
+	// config holds the configuration passed to every git command, e.g. the TLS and proxy settings
+	config []gitConfig
+}
+
+// gitConfig is a git configuration setting for a single command
+type gitConfig struct {
+	key, value string
+}
+
+func newGitBackend(u *url.URL, opts BackendOptions) (*gitBackend, error) {
+	ref := u.Query().Get("ref")
+	// git would parse a ref starting with a dash as an option, e.g. --upload-pack running a command
+	if strings.HasPrefix(ref, "-") {
+		return nil, fmt.Errorf("invalid Git URL %q: the ref %q must not start with a dash", u.String(), ref)
+	}
+
+	manifestPath := defaultGitManifestPath
+	if u.Fragment != "" {
+		manifestPath = path.Clean(strings.TrimPrefix(u.Fragment, "/"))
+		if manifestPath == "." || manifestPath == ".." || strings.HasPrefix(manifestPath, "../") {
+			return nil, fmt.Errorf("invalid Git URL %q: the path %q is outside the repository", u.String(), u.Fragment)
+		}
+	}
+
+	repository := *u
+	repository.Scheme = strings.TrimPrefix(u.Scheme, "git+")
+	repository.RawQuery = ""
+	repository.Fragment = ""
+
+	config, err := gitTransportConfig(opts.Transport)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gitBackend{
+		repository: repository.String(),
+		ref:        ref,
+		path:       manifestPath,
+		authToken:  opts.AuthToken,
+		config:     config,
+	}, nil
+}
+
+// gitTransportConfig returns the git configuration applying the transport options to HTTPS remotes.
+// Paths are made absolute, as git runs in a temporary directory.
+func gitTransportConfig(opts transport.Options) ([]gitConfig, error) {
+	var config []gitConfig
+	for _, setting := range []struct{ key, path string }{
+		{"http.sslCAInfo", opts.CACert},
+		{"http.sslCert", opts.ClientCert},
+		{"http.sslKey", opts.ClientKey},
+	} {
+		if setting.path == "" {
+			continue
+		}
+		path, err := filepath.Abs(setting.path)
+		if err != nil {
+			return nil, err
+		}
+		config = append(config, gitConfig{setting.key, path})
+	}
+	if opts.Proxy != "" {
+		config = append(config, gitConfig{"http.proxy", opts.Proxy})
+	}
+	return config, nil
+}
+
+// Pull fetches only the commit at the ref, so that any branch, tag or commit SHA can be pinned
+func (b *gitBackend) Pull(ctx context.Context) (*flagset.Flagset, error) {
+	dir, cleanup, err := b.fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	flags, err := LoadFromLocal(filepath.Join(dir, filepath.FromSlash(b.path)))
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s from %s: %w", b.path, b.repository, err)
+	}
+	return flags, nil
+}
+
+func (b *gitBackend) Push(ctx context.Context, flags *flagset.Flagset) error {
+	dir, cleanup, err := b.clone(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	data, err := marshalManifest(flags)
+	if err != nil {
+		return fmt.Errorf("failed to encode manifest: %w", err)
+	}
+	manifestPath := filepath.Join(dir, filepath.FromSlash(b.path))
+	if err := os.MkdirAll(filepath.Dir(manifestPath), 0o755); err != nil {
+		return fmt.Errorf("failed to create manifest directory: %w", err)
+	}
+	if err := os.WriteFile(manifestPath, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write manifest: %w", err)
+	}
+
+	if _, err := b.git(ctx, dir, "add", b.path); err != nil {
+		return err
+	}
+	status, err := b.git(ctx, dir, "status", "--porcelain", "--", b.path)
+	if err != nil {
+		return err
+	}
+	if status == "" {
+		logger.Default.Debug(fmt.Sprintf("Manifest in %s is unchanged, nothing to push", b.repository))
+		return nil
+	}
+
+	if _, err := b.git(ctx, dir, "commit", "-m", gitCommitMessage); err != nil {
+		return err
+	}
+	target := "HEAD"
+	if b.ref != "" {
+		target = "HEAD:" + b.ref
+	}
+	_, err = b.git(ctx, dir, "push", "origin", target)
+	return err
+}
+
+// fetch checks out the commit at the ref into a temporary directory, fetching no history.
+// Unlike clone, it accepts commit SHAs as well as branch and tag names.
+func (b *gitBackend) fetch(ctx context.Context) (string, func(), error) {
+	dir, cleanup, err := gitTempDir()
+	if err != nil {
+		return "", nil, err
+	}
+
+	ref := b.ref
+	if ref == "" {
+		ref = "HEAD"
+	}
+	for _, args := range [][]string{
+		{"init", "--quiet"},
+		{"fetch", "--depth", "1", "--", b.repository, ref},
+		{"checkout", "--quiet", "FETCH_HEAD"},
+	} {
+		if _, err := b.git(ctx, dir, args...); err != nil {
+			cleanup()
+			return "", nil, err
+		}
+	}
+	return dir, cleanup, nil
+}
+
+// clone makes a shallow clone of the branch at the ref into a temporary directory, ready to push
+func (b *gitBackend) clone(ctx context.Context) (string, func(), error) {
+	dir, cleanup, err := gitTempDir()
+	if err != nil {
+		return "", nil, err
+	}
+
+	args := []string{"clone", "--depth", "1"}
+	if b.ref != "" {
+		args = append(args, "--branch", b.ref)
+	}
+	args = append(args, "--", b.repository, dir)
+
+	if _, err := b.git(ctx, "", args...); err != nil {
+		cleanup()
+		return "", nil, err
+	}
+	return dir, cleanup, nil
+}
+
+// gitTempDir creates a temporary directory for a work tree and returns a function removing it
+func gitTempDir() (string, func(), error) {
+	dir, err := os.MkdirTemp("", "openfeature-git-*")
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to create temporary directory: %w", err)
+	}
+	return dir, func() { _ = os.RemoveAll(dir) }, nil
+}
+
+// git runs a git command and returns its trimmed output
+func (b *gitBackend) git(ctx context.Context, dir string, args ...string) (string, error) {
+	config := b.config
+	if b.authToken != "" {
+		config = append(slices.Clone(config), gitConfig{"http.extraHeader", "Authorization: Bearer " + b.authToken})
+	}
+
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = dir
+	// Never block on a credential prompt
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	cmd.Env = append(cmd.Env, gitConfigEnv(config)...)
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	logger.Default.Debug(fmt.Sprintf("Running git %s in %q", args[0], dir))
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("git %s failed: %w: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+// gitConfigEnv returns the environment variables passing the configuration to git. Unlike -c options,
+// they are not visible to other users in the process list, which matters for tokens and proxy
+// credentials. Settings already passed this way by the user are kept.
+func gitConfigEnv(config []gitConfig) []string {
+	if len(config) == 0 {
+		return nil
+	}
+
+	offset, err := strconv.Atoi(os.Getenv("GIT_CONFIG_COUNT"))
+	if err != nil || offset < 0 {
+		offset = 0
+	}
+
+	env := []string{fmt.Sprintf("GIT_CONFIG_COUNT=%d", offset+len(config))}
+	for i, setting := range config {
+		env = append(env,
+			fmt.Sprintf("GIT_CONFIG_KEY_%d=%s", offset+i, setting.key),
+			fmt.Sprintf("GIT_CONFIG_VALUE_%d=%s", offset+i, setting.value))
+	}
+	return env
+}
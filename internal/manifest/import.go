@@ -0,0 +1,345 @@
+package manifest
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/open-feature/cli/internal/flagset"
+	"go.yaml.in/yaml/v3"
+)
+
+// ImportFormat is a provider-specific flag configuration format that can be converted into a manifest
+type ImportFormat string
+
+const (
+	// ImportFormatFlagd is the flagd JSON flag definition format
+	ImportFormatFlagd ImportFormat = "flagd"
+	// ImportFormatGoFeatureFlag is the GO Feature Flag YAML format
+	ImportFormatGoFeatureFlag ImportFormat = "gofeatureflag"
+	// ImportFormatEnv is a .env file of boolean toggles
+	ImportFormatEnv ImportFormat = "env"
+)
+
+// GetValidImportFormats returns the import formats as a list of strings
+func GetValidImportFormats() []string {
+	return []string{
+		string(ImportFormatFlagd),
+		string(ImportFormatGoFeatureFlag),
+		string(ImportFormatEnv),
+	}
+}
+
+// ImportResult holds the flags converted from a provider-specific configuration
+type ImportResult struct {
+	Flagset *flagset.Flagset
+	// Warnings describe entries that could not be converted and were skipped
+	Warnings []string
+}
+
+// Import converts the given provider-specific configuration into a flagset.
+// Only the default value of each flag is kept; targeting rules and other variants are dropped.
+func Import(format ImportFormat, data []byte) (*ImportResult, error) {
+	var (
+		result *ImportResult
+		err    error
+	)
+	switch format {
+	case ImportFormatFlagd:
+		result, err = importFlagd(data)
+	case ImportFormatGoFeatureFlag:
+		result, err = importGoFeatureFlag(data)
+	case ImportFormatEnv:
+		result, err = importEnv(data)
+	default:
+		return nil, fmt.Errorf("unsupported import format: %s. Valid formats are: %s",
+			format, strings.Join(GetValidImportFormats(), ", "))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(result.Flagset.Flags, func(i, j int) bool {
+		return result.Flagset.Flags[i].Key < result.Flagset.Flags[j].Key
+	})
+	sort.Strings(result.Warnings)
+
+	return result, nil
+}
+
+// importFlagd converts flagd flag definitions, using the defaultVariant of each flag as its default value
+func importFlagd(data []byte) (*ImportResult, error) {
+	var config struct {
+		Flags map[string]struct {
+			Variants       map[string]any `json:"variants"`
+			DefaultVariant string         `json:"defaultVariant"`
+			Metadata       map[string]any `json:"metadata"`
+		} `json:"flags"`
+	}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("error parsing flagd configuration: %w", err)
+	}
+
+	result := &ImportResult{Flagset: &flagset.Flagset{Flags: []flagset.Flag{}}}
+	for key, def := range config.Flags {
+		flag, err := flagFromVariants(key, def.Variants, def.DefaultVariant)
+		if err != nil {
+			result.Warnings = append(result.Warnings, err.Error())
+			continue
+		}
+		// Exported float flags record their type, since integral float values are indistinguishable from integers
+		if flagType, ok := def.Metadata[flagdTypeMetadataKey].(string); ok && flagType == flagset.FloatType.String() && flag.Type == flagset.IntType {
+			flag.Type = flagset.FloatType
+			flag.DefaultValue = toFloat(def.Variants[def.DefaultVariant])
+		}
+		flag.Description = metadataDescription(def.Metadata)
+		result.Flagset.Flags = append(result.Flagset.Flags, flag)
+	}
+
+	return result, nil
+}
+
+// importGoFeatureFlag converts GO Feature Flag definitions, using the variation served by the default rule as the default value.
+// When the default rule splits traffic by percentage, the variation with the largest share is used.
+func importGoFeatureFlag(data []byte) (*ImportResult, error) {
+	var config map[string]struct {
+		Variations  map[string]any `yaml:"variations"`
+		DefaultRule struct {
+			Variation  string             `yaml:"variation"`
+			Percentage map[string]float64 `yaml:"percentage"`
+		} `yaml:"defaultRule"`
+		Metadata map[string]any `yaml:"metadata"`
+	}
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("error parsing GO Feature Flag configuration: %w", err)
+	}
+
+	result := &ImportResult{Flagset: &flagset.Flagset{Flags: []flagset.Flag{}}}
+	for key, def := range config {
+		defaultVariation := def.DefaultRule.Variation
+		if defaultVariation == "" {
+			defaultVariation = largestPercentage(def.DefaultRule.Percentage)
+		}
+
+		flag, err := flagFromVariants(key, def.Variations, defaultVariation)
+		if err != nil {
+			result.Warnings = append(result.Warnings, err.Error())
+			continue
+		}
+		flag.Description = metadataDescription(def.Metadata)
+		result.Flagset.Flags = append(result.Flagset.Flags, flag)
+	}
+
+	return result, nil
+}
+
+// importEnv converts KEY=value lines with boolean values into boolean flags.
+// Comments, blank lines and an optional export prefix are ignored; lines with other values are skipped.
+func importEnv(data []byte) (*ImportResult, error) {
+	result := &ImportResult{Flagset: &flagset.Flagset{Flags: []flagset.Flag{}}}
+	seen := make(map[string]bool)
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+
+		key, value, found := strings.Cut(line, "=")
+		key = strings.TrimSpace(key)
+		if !found || key == "" {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("line %d: expected KEY=value", lineNumber))
+			continue
+		}
+
+		value = strings.Trim(strings.TrimSpace(value), `"'`)
+		boolValue, ok := parseEnvBool(value)
+		if !ok {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("%s: value %q is not a boolean toggle", key, value))
+			continue
+		}
+		if seen[key] {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("%s: defined more than once, keeping the first value", key))
+			continue
+		}
+		seen[key] = true
+
+		result.Flagset.Flags = append(result.Flagset.Flags, flagset.Flag{
+			Key:          key,
+			Type:         flagset.BoolType,
+			DefaultValue: boolValue,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading .env file: %w", err)
+	}
+
+	return result, nil
+}
+
+// flagFromVariants builds a flag from a set of variants, inferring the flag type from the variant values
+func flagFromVariants(key string, variants map[string]any, defaultVariant string) (flagset.Flag, error) {
+	if defaultVariant == "" {
+		return flagset.Flag{}, fmt.Errorf("%s: no default variant", key)
+	}
+	defaultValue, ok := variants[defaultVariant]
+	if !ok {
+		return flagset.Flag{}, fmt.Errorf("%s: default variant %q is not defined", key, defaultVariant)
+	}
+
+	names := make([]string, 0, len(variants))
+	for name := range variants {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	values := make([]any, 0, len(variants))
+	for _, name := range names {
+		values = append(values, variants[name])
+	}
+	flagType, err := inferFlagType(values)
+	if err != nil {
+		return flagset.Flag{}, fmt.Errorf("%s: %w", key, err)
+	}
+
+	if flagType == flagset.IntType {
+		defaultValue = int(toFloat(defaultValue))
+	} else if flagType == flagset.ObjectType {
+		defaultValue = normalizeObject(defaultValue)
+	}
+
+	return flagset.Flag{
+		Key:          key,
+		Type:         flagType,
+		DefaultValue: defaultValue,
+	}, nil
+}
+
+// inferFlagType determines the flag type shared by all variant values.
+// Numbers are integers unless at least one value has a fractional part.
+func inferFlagType(values []any) (flagset.FlagType, error) {
+	flagType := flagset.UnknownFlagType
+	for _, value := range values {
+		var valueType flagset.FlagType
+		switch v := value.(type) {
+		case bool:
+			valueType = flagset.BoolType
+		case string:
+			valueType = flagset.StringType
+		case int, int64, uint64:
+			valueType = flagset.IntType
+		case float64:
+			valueType = flagset.IntType
+			if v != math.Trunc(v) {
+				valueType = flagset.FloatType
+			}
+		case map[string]any, []any:
+			valueType = flagset.ObjectType
+		default:
+			return flagset.UnknownFlagType, fmt.Errorf("unsupported variant value %v", value)
+		}
+
+		switch {
+		case flagType == flagset.UnknownFlagType || flagType == valueType:
+			flagType = valueType
+		case isNumeric(flagType) && isNumeric(valueType):
+			flagType = flagset.FloatType
+		default:
+			return flagset.UnknownFlagType, fmt.Errorf("variants mix %s and %s values", flagType, valueType)
+		}
+	}
+	if flagType == flagset.UnknownFlagType {
+		return flagset.UnknownFlagType, fmt.Errorf("no variants defined")
+	}
+	return flagType, nil
+}
+
+func isNumeric(flagType flagset.FlagType) bool {
+	return flagType == flagset.IntType || flagType == flagset.FloatType
+}
+
+func toFloat(value any) float64 {
+	switch v := value.(type) {
+	case int:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case uint64:
+		return float64(v)
+	case float64:
+		return v
+	default:
+		return 0
+	}
+}
+
+// normalizeObject converts YAML-decoded values into their JSON-compatible equivalents
+func normalizeObject(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		normalized := make(map[string]any, len(v))
+		for key, item := range v {
+			normalized[key] = normalizeObject(item)
+		}
+		return normalized
+	case []any:
+		normalized := make([]any, len(v))
+		for i, item := range v {
+			normalized[i] = normalizeObject(item)
+		}
+		return normalized
+	case int:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case uint64:
+		return float64(v)
+	default:
+		return v
+	}
+}
+
+// largestPercentage returns the variation with the largest share, preferring the first name alphabetically on ties
+func largestPercentage(percentages map[string]float64) string {
+	names := make([]string, 0, len(percentages))
+	for name := range percentages {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	best := ""
+	for _, name := range names {
+		if best == "" || percentages[name] > percentages[best] {
+			best = name
+		}
+	}
+	return best
+}
+
+func metadataDescription(metadata map[string]any) string {
+	if description, ok := metadata["description"].(string); ok {
+		return description
+	}
+	return ""
+}
+
+func parseEnvBool(value string) (bool, bool) {
+	switch strings.ToLower(value) {
+	case "true", "on", "yes", "enabled":
+		return true, true
+	case "false", "off", "no", "disabled":
+		return false, true
+	}
+	if b, err := strconv.ParseBool(value); err == nil {
+		return b, true
+	}
+	return false, false
+}
@@ -0,0 +1,167 @@
+package manifest
+
+import (
+	"testing"
+
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImport(t *testing.T) {
+	tests := []struct {
+		name             string
+		format           ImportFormat
+		input            string
+		expectedFlags    []flagset.Flag
+		expectedWarnings []string
+		expectedError    string
+	}{
+		{
+			name:   "flagd definitions",
+			format: ImportFormatFlagd,
+			input: `{
+				"$schema": "https://flagd.dev/schema/v0/flags.json",
+				"flags": {
+					"show-banner": {
+						"state": "ENABLED",
+						"variants": {"on": true, "off": false},
+						"defaultVariant": "off",
+						"metadata": {"description": "Show the promotional banner"}
+					},
+					"header-color": {
+						"state": "ENABLED",
+						"variants": {"red": "#FF0000", "blue": "#0000FF"},
+						"defaultVariant": "blue",
+						"targeting": {"if": [{"==": [{"var": "tier"}, "gold"]}, "red", null]}
+					},
+					"max-items": {
+						"state": "ENABLED",
+						"variants": {"small": 10, "large": 50},
+						"defaultVariant": "large"
+					},
+					"discount": {
+						"state": "ENABLED",
+						"variants": {"none": 0, "some": 0.15},
+						"defaultVariant": "some"
+					},
+					"theme": {
+						"state": "ENABLED",
+						"variants": {"dark": {"background": "black"}, "light": {"background": "white"}},
+						"defaultVariant": "light"
+					}
+				}
+			}`,
+			expectedFlags: []flagset.Flag{
+				{Key: "discount", Type: flagset.FloatType, DefaultValue: 0.15},
+				{Key: "header-color", Type: flagset.StringType, DefaultValue: "#0000FF"},
+				{Key: "max-items", Type: flagset.IntType, DefaultValue: 50},
+				{Key: "show-banner", Type: flagset.BoolType, DefaultValue: false, Description: "Show the promotional banner"},
+				{Key: "theme", Type: flagset.ObjectType, DefaultValue: map[string]any{"background": "white"}},
+			},
+		},
+		{
+			name:   "flagd definitions with unconvertible flags",
+			format: ImportFormatFlagd,
+			input: `{
+				"flags": {
+					"missing-default": {"variants": {"on": true}, "defaultVariant": "off"},
+					"mixed": {"variants": {"a": true, "b": "yes"}, "defaultVariant": "a"},
+					"valid": {"variants": {"on": true, "off": false}, "defaultVariant": "on"}
+				}
+			}`,
+			expectedFlags: []flagset.Flag{
+				{Key: "valid", Type: flagset.BoolType, DefaultValue: true},
+			},
+			expectedWarnings: []string{
+				`missing-default: default variant "off" is not defined`,
+				"mixed: variants mix boolean and string values",
+			},
+		},
+		{
+			name:          "invalid flagd JSON",
+			format:        ImportFormatFlagd,
+			input:         `{"flags": `,
+			expectedError: "error parsing flagd configuration",
+		},
+		{
+			name:   "GO Feature Flag configuration",
+			format: ImportFormatGoFeatureFlag,
+			input: `new-checkout:
+  variations:
+    enabled: true
+    disabled: false
+  defaultRule:
+    variation: disabled
+  metadata:
+    description: Roll out the new checkout flow
+retry-limit:
+  variations:
+    low: 2
+    high: 5
+  targeting:
+    - query: beta eq true
+      variation: high
+  defaultRule:
+    percentage:
+      low: 80
+      high: 20
+layout:
+  variations:
+    compact:
+      columns: 2
+    wide:
+      columns: 4
+  defaultRule:
+    variation: wide
+`,
+			expectedFlags: []flagset.Flag{
+				{Key: "layout", Type: flagset.ObjectType, DefaultValue: map[string]any{"columns": float64(4)}},
+				{Key: "new-checkout", Type: flagset.BoolType, DefaultValue: false, Description: "Roll out the new checkout flow"},
+				{Key: "retry-limit", Type: flagset.IntType, DefaultValue: 2},
+			},
+		},
+		{
+			name:   ".env boolean toggles",
+			format: ImportFormatEnv,
+			input: `# Feature toggles
+FEATURE_NEW_CHECKOUT=true
+export FEATURE_DARK_MODE="off"
+
+DATABASE_URL=postgres://localhost
+FEATURE_NEW_CHECKOUT=false
+not a toggle
+`,
+			expectedFlags: []flagset.Flag{
+				{Key: "FEATURE_DARK_MODE", Type: flagset.BoolType, DefaultValue: false},
+				{Key: "FEATURE_NEW_CHECKOUT", Type: flagset.BoolType, DefaultValue: true},
+			},
+			expectedWarnings: []string{
+				`DATABASE_URL: value "postgres://localhost" is not a boolean toggle`,
+				"FEATURE_NEW_CHECKOUT: defined more than once, keeping the first value",
+				"line 7: expected KEY=value",
+			},
+		},
+		{
+			name:          "unsupported format",
+			format:        ImportFormat("launchdarkly"),
+			input:         `{}`,
+			expectedError: "unsupported import format: launchdarkly",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Import(tt.format, []byte(tt.input))
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedFlags, result.Flagset.Flags)
+			assert.ElementsMatch(t, tt.expectedWarnings, result.Warnings)
+		})
+	}
+}
@@ -50,12 +50,30 @@ type ObjectFlag struct {
 type BaseFlag struct {
 	// The type of feature flag (e.g., boolean, string, integer, float)
 	Type string `json:"flagType,omitempty" jsonschema:"required"`
+	// A human-friendly display name for this feature flag. Defaults to the flag key when omitted.
+	Name string `json:"name,omitempty"`
 	// A concise description of this feature flag's purpose.
 	Description string `json:"description,omitempty"`
+	// The team or individual responsible for this feature flag.
+	Owner string `json:"owner,omitempty"`
+	// The date this feature flag was created (YYYY-MM-DD).
+	CreatedAt string `json:"createdAt,omitempty" jsonschema:"format=date"`
+	// The date after which this feature flag is considered stale (YYYY-MM-DD).
+	ExpiresAt string `json:"expiresAt,omitempty" jsonschema:"format=date"`
+	// Whether this feature flag is expected to be removed (temporary) or kept indefinitely (permanent).
+	Lifecycle string `json:"lifecycle,omitempty" jsonschema:"enum=temporary,enum=permanent"`
+	// Marks this feature flag as deprecated. The value explains what to use instead.
+	Deprecated string `json:"deprecated,omitempty"`
+	// The key this feature flag had before it was renamed, so that comparisons report a rename instead of a removal and an addition.
+	RenamedFrom string `json:"renamedFrom,omitempty"`
+	// Labels used to group this feature flag, for example by the applications that use it.
+	Tags []string `json:"tags,omitempty" jsonschema:"uniqueItems=true"`
 }
 
 // Feature flag manifest for the OpenFeature CLI
 type Manifest struct {
+	// Manifests whose flags are inherited, as paths relative to this manifest, file:// or https:// URLs. Flags defined in this manifest override inherited flags with the same key.
+	Extends []string `json:"extends,omitempty"`
 	// Collection of feature flag definitions
 	Flags map[string]any `json:"flags" jsonschema:"title=Flags,required"`
 }
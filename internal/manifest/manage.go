@@ -1,6 +1,7 @@
 package manifest
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -30,8 +31,52 @@ func Create(path string) error {
 	return writeManifest(path, m)
 }
 
-// LoadFlagSet loads, validates, and unmarshals the manifest file at the given path into a flagset
-func LoadFlagSet(manifestPath string) (*flagset.Flagset, error) {
+// LoadFlagSet loads, validates, and unmarshals the manifest at the given path into a flagset.
+// The path may also be a directory or glob of manifest fragments, which are merged into one flagset.
+func LoadFlagSet(manifestPath string, opts LoadOptions) (*flagset.Flagset, error) {
+	fs, _, err := LoadFlagSources(manifestPath, opts)
+	return fs, err
+}
+
+// LoadLocalFlagSet loads only the flags defined in the manifest file itself, without resolving the manifests it extends.
+// Use it when the manifest is about to be modified and written back.
+func LoadLocalFlagSet(manifestPath string) (*flagset.Flagset, error) {
+	data, err := readValidatedManifest(manifestPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var flagset flagset.Flagset
+	if err := json.Unmarshal(data, &flagset); err != nil {
+		return nil, fmt.Errorf("error unmarshaling JSON: %v", err)
+	}
+
+	return &flagset, nil
+}
+
+// loadFlagSetFile loads, validates, and unmarshals a single manifest file into a flagset,
+// including the flags inherited from the manifests it extends
+func loadFlagSetFile(manifestPath string, opts LoadOptions) (*flagset.Flagset, error) {
+	data, err := readValidatedManifest(manifestPath)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err = resolveExtends(data, manifestPath, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var flagset flagset.Flagset
+	if err := json.Unmarshal(data, &flagset); err != nil {
+		return nil, fmt.Errorf("error unmarshaling JSON: %v", err)
+	}
+
+	return &flagset, nil
+}
+
+// readValidatedManifest reads the manifest file at the given path and validates it against the schema
+func readValidatedManifest(manifestPath string) ([]byte, error) {
 	fs := filesystem.FileSystem()
 	data, err := afero.ReadFile(fs, manifestPath)
 	if err != nil {
@@ -46,27 +91,93 @@ func LoadFlagSet(manifestPath string) (*flagset.Flagset, error) {
 		return nil, errors.New(FormatValidationError(validationErrors))
 	}
 
-	var flagset flagset.Flagset
-	if err := json.Unmarshal(data, &flagset); err != nil {
-		return nil, fmt.Errorf("error unmarshaling JSON: %v", err)
+	return data, nil
+}
+
+// Write writes a flagset to a manifest file at the given path. The flagset is the complete set of flags,
+// so any extends references of the existing manifest are dropped.
+func Write(path string, flagset flagset.Flagset) error {
+	return writeManifest(path, createInitManifest(manifestEntries(flagset)))
+}
+
+// WriteLocal writes the flags defined by a manifest itself, as loaded by LoadLocalFlagSet,
+// to the manifest file at the given path while keeping its extends references
+func WriteLocal(path string, flagset flagset.Flagset) error {
+	m := createInitManifest(manifestEntries(flagset))
+	m.Extends = existingExtends(path)
+	return writeManifest(path, m)
+}
+
+// IsUpToDate reports whether writing the flagset to the manifest at the given path with Write would leave it unchanged
+func IsUpToDate(path string, flagset flagset.Flagset) bool {
+	existing, err := afero.ReadFile(filesystem.FileSystem(), path)
+	if err != nil {
+		return false
 	}
 
-	return &flagset, nil
+	formattedManifest, err := encodeManifest(createInitManifest(manifestEntries(flagset)))
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(existing, formattedManifest)
 }
 
-// Write writes a flagset to a manifest file at the given path
-func Write(path string, flagset flagset.Flagset) error {
+// manifestEntries returns the manifest entries of the flags keyed by flag key
+func manifestEntries(flagset flagset.Flagset) map[string]any {
 	flags := make(map[string]any)
 	for _, flag := range flagset.Flags {
-		flags[flag.Key] = map[string]any{
-			"flagType":     flag.Type.String(),
-			"description":  flag.Description,
-			"defaultValue": flag.DefaultValue,
-		}
+		flags[flag.Key] = flagToManifestEntry(flag)
 	}
+	return flags
+}
 
-	m := createInitManifest(flags)
-	return writeManifest(path, m)
+// existingExtends returns the extends references of the manifest at the given path so that rewriting
+// its local flags keeps them. Missing or unreadable manifests have no references.
+func existingExtends(path string) []string {
+	data, err := afero.ReadFile(filesystem.FileSystem(), path)
+	if err != nil {
+		return nil
+	}
+
+	var m Manifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil
+	}
+	return m.Extends
+}
+
+// flagToManifestEntry converts a flag into its manifest representation, omitting unset optional fields
+func flagToManifestEntry(flag flagset.Flag) map[string]any {
+	entry := map[string]any{
+		"flagType":     flag.Type.String(),
+		"description":  flag.Description,
+		"defaultValue": flag.DefaultValue,
+	}
+	if flag.Name != "" {
+		entry["name"] = flag.Name
+	}
+	if flag.Owner != "" {
+		entry["owner"] = flag.Owner
+	}
+	if flag.CreatedAt != "" {
+		entry["createdAt"] = flag.CreatedAt
+	}
+	if flag.ExpiresAt != "" {
+		entry["expiresAt"] = flag.ExpiresAt
+	}
+	if flag.Lifecycle != "" {
+		entry["lifecycle"] = string(flag.Lifecycle)
+	}
+	if flag.Deprecated != "" {
+		entry["deprecated"] = flag.Deprecated
+	}
+	if flag.RenamedFrom != "" {
+		entry["renamedFrom"] = flag.RenamedFrom
+	}
+	if len(flag.Tags) > 0 {
+		entry["tags"] = flag.Tags
+	}
+	return entry
 }
 
 // LoadFromLocal loads flags from a local file path
@@ -102,33 +213,65 @@ func LoadFromSyncAPI(baseURL string, authToken string) (*flagset.Flagset, error)
 // LoadFromRemote loads flags from a remote URL using direct HTTP requests
 // This is a fallback for sources that don't implement the sync API specification
 func LoadFromRemote(url string, authToken string) (*flagset.Flagset, error) {
-	req, err := http.NewRequest("GET", url, nil)
+	flags, _, err := loadFromRemoteIfModified(context.Background(), url, sync.StaticToken(authToken), http.DefaultClient, sync.Validators{})
+	return flags, err
+}
+
+// loadFromRemoteIfModified loads flags from a remote URL unless they still match the validators,
+// in which case it returns nil flags. A request rejected with 401 is sent again once the token
+// source has refreshed its token.
+func loadFromRemoteIfModified(ctx context.Context, url string, tokens sync.TokenSource, httpClient *http.Client, validators sync.Validators) (*flagset.Flagset, sync.Validators, error) {
+	resp, body, err := getRemote(ctx, httpClient, url, tokens, validators)
+	if err == nil && resp.StatusCode == http.StatusUnauthorized && tokens.Refresh() {
+		resp, body, err = getRemote(ctx, httpClient, url, tokens, validators)
+	}
 	if err != nil {
-		return nil, err
+		return nil, sync.Validators{}, err
 	}
 
-	if authToken != "" {
-		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", authToken))
+	logger.Default.Debug(fmt.Sprintf("Fetched from %s (status %d):\n%s", url, resp.StatusCode, string(body)))
+
+	if resp.StatusCode == http.StatusNotModified {
+		return nil, sync.ValidatorsFromResponse(resp), nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, sync.Validators{}, fmt.Errorf("received error response from flag source: %s", string(body))
 	}
 
-	resp, err := http.DefaultClient.Do(req)
+	flags, err := loadFlagsFromData(body)
 	if err != nil {
-		return nil, err
+		return nil, sync.Validators{}, err
 	}
-	defer resp.Body.Close()
+	return flags, sync.ValidatorsFromResponse(resp), nil
+}
 
-	body, err := io.ReadAll(resp.Body)
+// getRemote sends a conditional GET request with a token from the token source and returns the response and its body
+func getRemote(ctx context.Context, httpClient *http.Client, url string, tokens sync.TokenSource, validators sync.Validators) (*http.Response, []byte, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	logger.Default.Debug(fmt.Sprintf("Fetched from %s (status %d):\n%s", url, resp.StatusCode, string(body)))
+	authToken, err := tokens.Token(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if authToken != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", authToken))
+	}
+	validators.SetConditionalHeaders(req)
 
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("received error response from flag source: %s", string(body))
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, nil, err
 	}
+	defer resp.Body.Close()
 
-	return loadFlagsFromData(body)
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp, body, nil
 }
 
 // URLLooksLikeAFile checks if the given URL string appears to point to a file
@@ -154,11 +297,10 @@ func createInitManifest(flags map[string]any) *initManifest {
 
 // writeManifest marshals and writes a manifest to the given path atomically
 func writeManifest(path string, manifest *initManifest) error {
-	formattedManifest, err := json.MarshalIndent(manifest, "", "  ")
+	formattedManifest, err := encodeManifest(manifest)
 	if err != nil {
 		return err
 	}
-	formattedManifest = append(formattedManifest, '\n')
 
 	fs := filesystem.FileSystem()
 	dir := filepath.Dir(path)
@@ -191,6 +333,15 @@ func writeManifest(path string, manifest *initManifest) error {
 	return nil
 }
 
+// encodeManifest formats a manifest as it is written to disk
+func encodeManifest(manifest *initManifest) ([]byte, error) {
+	formattedManifest, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(formattedManifest, '\n'), nil
+}
+
 // loadFlagsFromData attempts to load flags from JSON data using multiple formats
 func loadFlagsFromData(data []byte) (*flagset.Flagset, error) {
 	// Try the standard manifest format first (with flags as object keys)
@@ -213,9 +364,18 @@ func loadFlagsFromData(data []byte) (*flagset.Flagset, error) {
 // compares them with local flags, and intelligently creates or updates
 // flags as needed. Returns a PushResult with details of what was changed.
 // If dryRun is true, only performs the comparison without making actual API calls.
-func SaveToRemote(url string, flags *flagset.Flagset, authToken string, dryRun bool) (*sync.PushResult, error) {
+// The auth and transport settings are taken from opts.
+func SaveToRemote(url string, flags *flagset.Flagset, opts BackendOptions, dryRun bool) (*sync.PushResult, error) {
+	httpClient, err := opts.httpClient(sync.DefaultTimeout)
+	if err != nil {
+		return nil, err
+	}
+	return saveToRemote(url, flags, opts.tokenSource(), httpClient, dryRun)
+}
+
+func saveToRemote(url string, flags *flagset.Flagset, tokens sync.TokenSource, httpClient *http.Client, dryRun bool) (*sync.PushResult, error) {
 	// Use the generated OpenAPI client for type-safe API calls
-	client, err := sync.NewClient(url, authToken)
+	client, err := sync.NewClientWithTokenSource(url, tokens, httpClient)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create push client: %w", err)
 	}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/flagset"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -103,3 +104,42 @@ func TestWriteManifestEndsWithNewline(t *testing.T) {
 	assert.True(t, len(data) > 0, "manifest file should not be empty")
 	assert.Equal(t, byte('\n'), data[len(data)-1], "manifest file should end with a newline")
 }
+
+func TestWritePreservesLifecycleMetadata(t *testing.T) {
+	memFs := afero.NewMemMapFs()
+	filesystem.SetFileSystem(memFs)
+	t.Cleanup(func() { filesystem.SetFileSystem(afero.NewOsFs()) })
+
+	fs := flagset.Flagset{
+		Flags: []flagset.Flag{
+			{
+				Key:          "new-checkout",
+				Type:         flagset.BoolType,
+				DefaultValue: false,
+				Name:         "New checkout",
+				Owner:        "team-payments",
+				CreatedAt:    "2025-01-15",
+				ExpiresAt:    "2025-06-30",
+				Lifecycle:    flagset.LifecycleTemporary,
+				Deprecated:   "use checkout-v2 instead",
+				RenamedFrom:  "checkout-beta",
+				Tags:         []string{"web", "mobile"},
+			},
+			{
+				Key:          "plain-flag",
+				Type:         flagset.StringType,
+				DefaultValue: "value",
+			},
+		},
+	}
+
+	require.NoError(t, Write("/flags.json", fs))
+
+	loaded, err := LoadFlagSet("/flags.json", LoadOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, fs.Flags, loaded.Flags)
+
+	data, err := afero.ReadFile(memFs, "/flags.json")
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), `"owner": ""`, "unset optional fields should be omitted")
+}
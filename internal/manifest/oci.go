@@ -0,0 +1,468 @@
+package manifest
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/open-feature/cli/internal/logger"
+)
+
+const (
+	ociImageManifestMediaType = "application/vnd.oci.image.manifest.v1+json"
+	ociEmptyConfigMediaType   = "application/vnd.oci.empty.v1+json"
+	defaultOCITag             = "latest"
+
+	// OCIArtifactType identifies OCI artifacts that hold a flag manifest
+	OCIArtifactType = "application/vnd.openfeature.manifest.v1"
+	// OCIManifestMediaType is the media type of the layer that holds the flag manifest
+	OCIManifestMediaType = "application/vnd.openfeature.manifest.v1+json"
+)
+
+// Annotations recorded on OCI artifacts pushed by the CLI
+const (
+	OCIAnnotationCreated   = "org.opencontainers.image.created"
+	OCIAnnotationRevision  = "org.opencontainers.image.revision"
+	OCIAnnotationTitle     = "org.opencontainers.image.title"
+	OCIAnnotationVersion   = "dev.openfeature.cli.version"
+	OCIAnnotationFlagCount = "dev.openfeature.manifest.flag-count"
+)
+
+// ociEmptyConfig is the config blob of artifacts that do not need a config
+var ociEmptyConfig = []byte("{}")
+
+// ociDescriptor describes content stored in a registry
+type ociDescriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// ociManifest is an OCI image manifest
+type ociManifest struct {
+	SchemaVersion int               `json:"schemaVersion"`
+	MediaType     string            `json:"mediaType"`
+	ArtifactType  string            `json:"artifactType,omitempty"`
+	Config        ociDescriptor     `json:"config"`
+	Layers        []ociDescriptor   `json:"layers"`
+	Annotations   map[string]string `json:"annotations,omitempty"`
+}
+
+// ociBackend stores a manifest as an artifact in an OCI registry.
+// URLs have the form oci://registry/namespace/name:tag or oci://registry/namespace/name@sha256:<digest>.
+type ociBackend struct {
+	// registry is the base URL of the registry, e.g. https://ghcr.io
+	registry   string
+	repository string
+	// reference is the tag or digest of the artifact
+	reference string
+	// authToken is either a bearer token or credentials of the form <username>:<password>
+	authToken string
+	// authorization is the Authorization header sent to the registry, updated when the registry
+	// challenges a request
+	authorization string
+	annotations   map[string]string
+	httpClient    *http.Client
+	// now returns the creation time of pushed artifacts and defaults to time.Now
+	now func() time.Time
+}
+
+func newOCIBackend(u *url.URL, opts BackendOptions) (*ociBackend, error) {
+	path := strings.TrimPrefix(u.Path, "/")
+	if u.Host == "" || path == "" {
+		return nil, fmt.Errorf("invalid OCI URL %q: expected oci://<registry>/<repository>[:<tag>]", u.String())
+	}
+
+	repository, reference := path, defaultOCITag
+	if i := strings.Index(path, "@"); i >= 0 {
+		repository, reference = path[:i], path[i+1:]
+	} else if i := strings.LastIndex(path, ":"); i > strings.LastIndex(path, "/") {
+		repository, reference = path[:i], path[i+1:]
+	}
+
+	httpClient, err := opts.httpClient(0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ociBackend{
+		registry:      registryBaseURL(u.Host),
+		repository:    repository,
+		reference:     reference,
+		authToken:     opts.AuthToken,
+		authorization: initialOCIAuthorization(opts.AuthToken),
+		annotations:   opts.Annotations,
+		httpClient:    httpClient,
+		now:           time.Now,
+	}, nil
+}
+
+// initialOCIAuthorization returns the Authorization header sent before the registry challenges a request.
+// Registries that accept the token directly are sent it as a bearer token, while credentials are only
+// sent once the registry asks for them.
+func initialOCIAuthorization(authToken string) string {
+	if authToken == "" || strings.Contains(authToken, ":") {
+		return ""
+	}
+	return "Bearer " + authToken
+}
+
+// registryBaseURL returns the base URL of a registry host. Registries on the local
+// machine are reached over plain HTTP, like container tooling does by default.
+func registryBaseURL(host string) string {
+	hostname := host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		hostname = h
+	}
+	if hostname == "localhost" || net.ParseIP(hostname).IsLoopback() {
+		return "http://" + host
+	}
+	return "https://" + host
+}
+
+func (b *ociBackend) Pull(ctx context.Context) (*flagset.Flagset, error) {
+	manifestData, err := b.get(ctx, fmt.Sprintf("manifests/%s", b.reference), ociImageManifestMediaType)
+	if err != nil {
+		return nil, err
+	}
+
+	var m ociManifest
+	if err := json.Unmarshal(manifestData, &m); err != nil {
+		return nil, fmt.Errorf("failed to parse OCI manifest: %w", err)
+	}
+	logger.Default.Debug(fmt.Sprintf("Pulled OCI artifact %s:%s with annotations %v", b.repository, b.reference, m.Annotations))
+
+	layer, err := manifestLayer(m)
+	if err != nil {
+		return nil, err
+	}
+	data, err := b.get(ctx, fmt.Sprintf("blobs/%s", layer.Digest), layer.MediaType)
+	if err != nil {
+		return nil, err
+	}
+	if digest := ociDigest(data); digest != layer.Digest {
+		return nil, fmt.Errorf("OCI layer digest mismatch: expected %s, got %s", layer.Digest, digest)
+	}
+
+	return loadFlagsFromData(data)
+}
+
+// manifestLayer returns the layer holding the flag manifest. Artifacts with a single layer
+// of another media type are accepted so that manifests pushed by other tools can be pulled.
+func manifestLayer(m ociManifest) (ociDescriptor, error) {
+	for _, layer := range m.Layers {
+		if layer.MediaType == OCIManifestMediaType {
+			return layer, nil
+		}
+	}
+	if len(m.Layers) == 1 {
+		return m.Layers[0], nil
+	}
+	return ociDescriptor{}, fmt.Errorf("OCI artifact does not contain a layer of type %s", OCIManifestMediaType)
+}
+
+func (b *ociBackend) Push(ctx context.Context, flags *flagset.Flagset) error {
+	if strings.Contains(b.reference, ":") {
+		return fmt.Errorf("pushing to a digest is not supported, use a tag such as oci://<registry>/%s:1.0.0", b.repository)
+	}
+
+	data, err := marshalManifest(flags)
+	if err != nil {
+		return fmt.Errorf("failed to encode manifest: %w", err)
+	}
+
+	config := ociDescriptor{MediaType: ociEmptyConfigMediaType, Digest: ociDigest(ociEmptyConfig), Size: int64(len(ociEmptyConfig))}
+	layer := ociDescriptor{
+		MediaType:   OCIManifestMediaType,
+		Digest:      ociDigest(data),
+		Size:        int64(len(data)),
+		Annotations: map[string]string{OCIAnnotationTitle: "flags.json"},
+	}
+	if err := b.uploadBlob(ctx, config.Digest, ociEmptyConfig); err != nil {
+		return err
+	}
+	if err := b.uploadBlob(ctx, layer.Digest, data); err != nil {
+		return err
+	}
+
+	annotations := map[string]string{
+		OCIAnnotationCreated:   b.now().UTC().Format(time.RFC3339),
+		OCIAnnotationFlagCount: strconv.Itoa(len(flags.Flags)),
+	}
+	maps.Copy(annotations, b.annotations)
+
+	manifestData, err := json.Marshal(ociManifest{
+		SchemaVersion: 2,
+		MediaType:     ociImageManifestMediaType,
+		ArtifactType:  OCIArtifactType,
+		Config:        config,
+		Layers:        []ociDescriptor{layer},
+		Annotations:   annotations,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode OCI manifest: %w", err)
+	}
+
+	endpoint := b.endpoint(fmt.Sprintf("manifests/%s", b.reference))
+	resp, body, err := b.do(ctx, http.MethodPut, endpoint, ociImageManifestMediaType, manifestData)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("failed to push OCI manifest to %s (status %d): %s", endpoint, resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+// uploadBlob uploads a blob unless the repository already has it, using a monolithic upload
+func (b *ociBackend) uploadBlob(ctx context.Context, digest string, data []byte) error {
+	resp, _, err := b.do(ctx, http.MethodHead, b.endpoint(fmt.Sprintf("blobs/%s", digest)), "", nil)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+
+	endpoint := b.endpoint("blobs/uploads/")
+	resp, body, err := b.do(ctx, http.MethodPost, endpoint, "", nil)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusAccepted {
+		return fmt.Errorf("failed to start blob upload to %s (status %d): %s", endpoint, resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	location, err := resp.Location()
+	if err != nil {
+		return fmt.Errorf("registry did not return an upload location: %w", err)
+	}
+	query := location.Query()
+	query.Set("digest", digest)
+	location.RawQuery = query.Encode()
+
+	resp, body, err = b.do(ctx, http.MethodPut, location.String(), "application/octet-stream", data)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("failed to upload blob %s (status %d): %s", digest, resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+// get fetches a manifest or blob of the repository
+func (b *ociBackend) get(ctx context.Context, path, accept string) ([]byte, error) {
+	endpoint := b.endpoint(path)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", accept)
+
+	resp, body, err := b.send(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch %s (status %d): %s", endpoint, resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return body, nil
+}
+
+// do sends a request with an optional body to the registry
+func (b *ociBackend) do(ctx context.Context, method, endpoint, contentType string, data []byte) (*http.Response, []byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, bytes.NewReader(data))
+	if err != nil {
+		return nil, nil, err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	return b.send(req)
+}
+
+// send authenticates and sends a request, returning the response and its body.
+// When the registry challenges the request, it authenticates as asked and retries the request once.
+func (b *ociBackend) send(req *http.Request) (*http.Response, []byte, error) {
+	resp, body, err := b.roundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, body, err
+	}
+
+	authenticated, err := b.authenticate(req.Context(), resp.Header.Get("WWW-Authenticate"))
+	if err != nil || !authenticated {
+		return resp, body, err
+	}
+
+	retry := req.Clone(req.Context())
+	if req.GetBody != nil {
+		if retry.Body, err = req.GetBody(); err != nil {
+			return nil, nil, err
+		}
+	}
+	return b.roundTrip(retry)
+}
+
+// roundTrip sends a request with the current authorization, returning the response and its body
+func (b *ociBackend) roundTrip(req *http.Request) (*http.Response, []byte, error) {
+	if b.authorization != "" {
+		req.Header.Set("Authorization", b.authorization)
+	}
+
+	logger.Default.Debug(fmt.Sprintf("OCI %s %s", req.Method, req.URL))
+	resp, err := b.httpClient.Do(req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to reach OCI registry: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp, body, nil
+}
+
+// authenticate answers a registry challenge and reports whether the request should be retried.
+// Basic challenges are answered with the configured credentials. Bearer challenges are answered by
+// requesting a token for the challenged scope from the registry's token service, sending the credentials
+// or the token, or anonymously to pull public artifacts.
+func (b *ociBackend) authenticate(ctx context.Context, challenge string) (bool, error) {
+	scheme, params := parseAuthChallenge(challenge)
+	switch strings.ToLower(scheme) {
+	case "basic":
+		if !strings.Contains(b.authToken, ":") {
+			return false, nil
+		}
+		authorization := "Basic " + base64.StdEncoding.EncodeToString([]byte(b.authToken))
+		if authorization == b.authorization {
+			return false, nil
+		}
+		b.authorization = authorization
+		return true, nil
+	case "bearer":
+		if params["realm"] == "" {
+			return false, nil
+		}
+		token, err := b.fetchRegistryToken(ctx, params)
+		if err != nil {
+			return false, err
+		}
+		b.authorization = "Bearer " + token
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+// fetchRegistryToken requests a token from the token service named in a bearer challenge
+func (b *ociBackend) fetchRegistryToken(ctx context.Context, params map[string]string) (string, error) {
+	realm, err := url.Parse(params["realm"])
+	if err != nil {
+		return "", fmt.Errorf("invalid OCI token realm %q: %w", params["realm"], err)
+	}
+	query := realm.Query()
+	for _, name := range []string{"service", "scope"} {
+		if params[name] != "" {
+			query.Set(name, params[name])
+		}
+	}
+	realm.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, realm.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	if username, password, ok := strings.Cut(b.authToken, ":"); ok {
+		req.SetBasicAuth(username, password)
+	} else if b.authToken != "" {
+		req.Header.Set("Authorization", "Bearer "+b.authToken)
+	}
+
+	logger.Default.Debug(fmt.Sprintf("OCI token request for %s scope %q", params["service"], params["scope"]))
+	resp, err := b.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to reach OCI token service: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to authenticate with %s (status %d): %s", realm.Host, resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var token struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.Unmarshal(body, &token); err != nil {
+		return "", fmt.Errorf("failed to parse OCI token response: %w", err)
+	}
+	if token.Token != "" {
+		return token.Token, nil
+	}
+	if token.AccessToken != "" {
+		return token.AccessToken, nil
+	}
+	return "", fmt.Errorf("OCI token service %s did not return a token", realm.Host)
+}
+
+// parseAuthChallenge parses a WWW-Authenticate header such as
+// Bearer realm="https://ghcr.io/token",service="ghcr.io",scope="repository:acme/flags:pull"
+func parseAuthChallenge(header string) (string, map[string]string) {
+	scheme, rest, _ := strings.Cut(strings.TrimSpace(header), " ")
+	params := make(map[string]string)
+	for rest = strings.TrimSpace(rest); rest != ""; {
+		name, value, ok := strings.Cut(rest, "=")
+		if !ok {
+			break
+		}
+		name = strings.ToLower(strings.TrimSpace(name))
+		value = strings.TrimSpace(value)
+
+		if strings.HasPrefix(value, `"`) {
+			// Quoted values may contain commas, e.g. scopes with several actions
+			end := strings.Index(value[1:], `"`)
+			if end < 0 {
+				params[name] = value[1:]
+				break
+			}
+			params[name] = value[1 : end+1]
+			rest = value[end+2:]
+		} else {
+			params[name], rest, _ = strings.Cut(value, ",")
+			params[name] = strings.TrimSpace(params[name])
+		}
+		rest = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(rest), ","))
+	}
+	return scheme, params
+}
+
+// endpoint returns the URL of a registry API path within the repository
+func (b *ociBackend) endpoint(path string) string {
+	return fmt.Sprintf("%s/v2/%s/%s", b.registry, b.repository, path)
+}
+
+// ociDigest returns the sha256 content digest of the data
+func ociDigest(data []byte) string {
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
@@ -12,12 +12,16 @@ const (
 	OutputFormatJSON OutputFormat = "json"
 	// OutputFormatYAML represents the YAML output format
 	OutputFormatYAML OutputFormat = "yaml"
+	// OutputFormatMarkdown represents the Markdown output format, e.g. for pull request comments
+	OutputFormatMarkdown OutputFormat = "markdown"
+	// OutputFormatHTML represents the HTML output format, e.g. for pull request comments
+	OutputFormatHTML OutputFormat = "html"
 )
 
 // IsValidOutputFormat checks if the given format is a valid output format
 func IsValidOutputFormat(format string) bool {
 	switch OutputFormat(format) {
-	case OutputFormatTree, OutputFormatFlat, OutputFormatJSON, OutputFormatYAML:
+	case OutputFormatTree, OutputFormatFlat, OutputFormatJSON, OutputFormatYAML, OutputFormatMarkdown, OutputFormatHTML:
 		return true
 	default:
 		return false
@@ -31,5 +35,7 @@ func GetValidOutputFormats() []string {
 		string(OutputFormatFlat),
 		string(OutputFormatJSON),
 		string(OutputFormatYAML),
+		string(OutputFormatMarkdown),
+		string(OutputFormatHTML),
 	}
 }
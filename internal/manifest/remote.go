@@ -0,0 +1,76 @@
+package manifest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/open-feature/cli/internal/flagset"
+)
+
+// IsRemoteSource reports whether the location is a URL with a scheme supported by NewBackend,
+// such as a provider URL, rather than a local path
+func IsRemoteSource(location string) bool {
+	u, err := url.Parse(location)
+	if err != nil {
+		return false
+	}
+	return slices.Contains(SupportedSchemes, u.Scheme)
+}
+
+// PullManifest pulls the flags from a location supported by NewBackend and returns them as a
+// manifest, normalized like NormalizeManifest so that it can be compared with a local manifest
+func PullManifest(ctx context.Context, location string, opts BackendOptions) (*Manifest, error) {
+	backend, err := NewBackend(location, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	flags, err := backend.Pull(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return FromFlagset(flags), nil
+}
+
+// FromFlagset returns the manifest describing the flags, as it would be written by pull
+func FromFlagset(flags *flagset.Flagset) *Manifest {
+	entries := make(map[string]any, len(flags.Flags))
+	for _, flag := range flags.Flags {
+		entries[flag.Key] = normalizeEntry(flagToManifestEntry(flag))
+	}
+	return &Manifest{Flags: entries}
+}
+
+// NormalizeManifest returns the manifest as it would be written by pull, so that manifests read
+// from files and from providers can be compared without reporting differences in representation,
+// such as an empty description that is omitted on one side
+func NormalizeManifest(m *Manifest) (*Manifest, error) {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+
+	flags, err := loadFlagsFromData(data)
+	if err != nil {
+		return nil, fmt.Errorf("error normalizing manifest: %w", err)
+	}
+	return FromFlagset(flags), nil
+}
+
+// normalizeEntry converts a manifest entry to the generic JSON types of a decoded manifest file,
+// e.g. float64 for numbers and []any for tags
+func normalizeEntry(entry map[string]any) any {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return entry
+	}
+
+	var normalized any
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return entry
+	}
+	return normalized
+}
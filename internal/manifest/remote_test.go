@@ -0,0 +1,52 @@
+package manifest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsRemoteSource(t *testing.T) {
+	tests := []struct {
+		location string
+		expected bool
+	}{
+		{"flags.json", false},
+		{"manifests/*.json", false},
+		{"/abs/path/flags.json", false},
+		{"file:///abs/path/flags.json", true},
+		{"https://flags.example.com", true},
+		{"http://localhost:8080/flags.json", true},
+		{"s3://bucket/flags.json", true},
+		{"git+https://github.com/org/flags.git#flags.json", true},
+		{"oci://ghcr.io/org/flags:1.0.0", true},
+		{"ftp://example.com/flags.json", false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, IsRemoteSource(tt.location), tt.location)
+	}
+}
+
+func TestNormalizeManifestMatchesPulledFlags(t *testing.T) {
+	local := &Manifest{
+		Flags: map[string]any{
+			"max-items": map[string]any{
+				"flagType":     "integer",
+				"defaultValue": float64(10),
+				"tags":         []any{"ui"},
+			},
+		},
+	}
+
+	normalized, err := NormalizeManifest(local)
+	require.NoError(t, err)
+
+	pulled, err := loadFlagsFromData([]byte(`{"flags": {"max-items": {"flagType": "integer", "defaultValue": 10, "description": "", "tags": ["ui"]}}}`))
+	require.NoError(t, err)
+
+	changes, err := Compare(normalized, FromFlagset(pulled), CompareOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+}
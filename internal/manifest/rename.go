@@ -0,0 +1,169 @@
+package manifest
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// renameSimilarityThreshold is the minimum similarity of the descriptions of a removed and an added
+// flag for them to be reported as a rename
+const renameSimilarityThreshold = 0.8
+
+// detectRenames replaces pairs of a removal and an addition that describe the same flag with a rename.
+// A flag is renamed when its renamedFrom field names the removed flag, or when both flags have the same
+// type, the same default value and similar descriptions.
+func detectRenames(changes []Change, oldFlags, newFlags map[string]any) []Change {
+	removed := make(map[string]int)
+	var added []string
+	for i, change := range changes {
+		key := strings.TrimPrefix(change.Path, "flags.")
+		switch change.Type {
+		case "remove":
+			removed[key] = i
+		case "add":
+			added = append(added, key)
+		}
+	}
+	if len(removed) == 0 || len(added) == 0 {
+		return changes
+	}
+	sort.Strings(added)
+
+	renames := make(map[string]string) // added key -> removed key
+	claimed := make(map[string]bool)
+
+	// Explicit renames take precedence over the heuristic
+	for _, newKey := range added {
+		oldKey, _ := flagField(newFlags[newKey], "renamedFrom").(string)
+		if _, ok := removed[oldKey]; ok && !claimed[oldKey] {
+			renames[newKey] = oldKey
+			claimed[oldKey] = true
+		}
+	}
+
+	// Pair the most similar flags first, so that each flag is part of at most one rename
+	type candidate struct {
+		newKey, oldKey string
+		similarity     float64
+	}
+	var candidates []candidate
+	for _, newKey := range added {
+		if _, ok := renames[newKey]; ok {
+			continue
+		}
+		for oldKey := range removed {
+			if claimed[oldKey] {
+				continue
+			}
+			if similarity, ok := renameSimilarity(oldFlags[oldKey], newFlags[newKey]); ok {
+				candidates = append(candidates, candidate{newKey, oldKey, similarity})
+			}
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].similarity != candidates[j].similarity {
+			return candidates[i].similarity > candidates[j].similarity
+		}
+		if candidates[i].newKey != candidates[j].newKey {
+			return candidates[i].newKey < candidates[j].newKey
+		}
+		return candidates[i].oldKey < candidates[j].oldKey
+	})
+	for _, c := range candidates {
+		if _, ok := renames[c.newKey]; ok || claimed[c.oldKey] {
+			continue
+		}
+		renames[c.newKey] = c.oldKey
+		claimed[c.oldKey] = true
+	}
+
+	if len(renames) == 0 {
+		return changes
+	}
+
+	result := make([]Change, 0, len(changes)-len(renames))
+	for _, change := range changes {
+		key := strings.TrimPrefix(change.Path, "flags.")
+		switch {
+		case change.Type == "remove" && claimed[key]:
+			continue
+		case change.Type == "add" && renames[key] != "":
+			oldKey := renames[key]
+			result = append(result, Change{
+				Type:     "rename",
+				Path:     change.Path,
+				OldPath:  changes[removed[oldKey]].Path,
+				OldValue: changes[removed[oldKey]].OldValue,
+				NewValue: change.NewValue,
+			})
+		default:
+			result = append(result, change)
+		}
+	}
+	return result
+}
+
+// renameSimilarity returns the similarity of the descriptions of two flags, and whether the flags
+// are similar enough to be a rename of each other
+func renameSimilarity(oldFlag, newFlag any) (float64, bool) {
+	oldType, newType := flagField(oldFlag, "flagType"), flagField(newFlag, "flagType")
+	if oldType == nil || !reflect.DeepEqual(oldType, newType) {
+		return 0, false
+	}
+	if !reflect.DeepEqual(flagField(oldFlag, "defaultValue"), flagField(newFlag, "defaultValue")) {
+		return 0, false
+	}
+
+	// Flags without descriptions are too alike to tell a rename from an unrelated flag
+	oldDescription, _ := flagField(oldFlag, "description").(string)
+	newDescription, _ := flagField(newFlag, "description").(string)
+	if strings.TrimSpace(oldDescription) == "" || strings.TrimSpace(newDescription) == "" {
+		return 0, false
+	}
+
+	similarity := stringSimilarity(oldDescription, newDescription)
+	return similarity, similarity >= renameSimilarityThreshold
+}
+
+// flagField returns a field of a manifest flag, or nil when it is not set
+func flagField(flag any, field string) any {
+	fields, ok := flag.(map[string]any)
+	if !ok {
+		return nil
+	}
+	return fields[field]
+}
+
+// stringSimilarity returns the similarity of two strings between 0 and 1, based on their
+// Levenshtein distance and ignoring case and surrounding whitespace
+func stringSimilarity(a, b string) float64 {
+	ar := []rune(strings.ToLower(strings.TrimSpace(a)))
+	br := []rune(strings.ToLower(strings.TrimSpace(b)))
+	longest := max(len(ar), len(br))
+	if longest == 0 {
+		return 1
+	}
+	return 1 - float64(levenshtein(ar, br))/float64(longest)
+}
+
+// levenshtein returns the number of single-rune edits that turn a into b
+func levenshtein(a, b []rune) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
@@ -0,0 +1,130 @@
+package manifest
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareDetectRenames(t *testing.T) {
+	checkout := map[string]any{"flagType": "boolean", "defaultValue": false, "description": "Enable the new checkout flow"}
+
+	tests := []struct {
+		name     string
+		oldFlags map[string]any
+		newFlags map[string]any
+		expected []Change
+	}{
+		{
+			name:     "same type, default and similar description",
+			oldFlags: map[string]any{"new-checkout": checkout},
+			newFlags: map[string]any{
+				"checkout-v2": map[string]any{"flagType": "boolean", "defaultValue": false, "description": "Enable the new checkout flow."},
+			},
+			expected: []Change{{
+				Type: "rename", Path: "flags.checkout-v2", OldPath: "flags.new-checkout",
+				OldValue: checkout,
+				NewValue: map[string]any{"flagType": "boolean", "defaultValue": false, "description": "Enable the new checkout flow."},
+				Severity: SeverityBreaking,
+			}},
+		},
+		{
+			name:     "explicit renamedFrom",
+			oldFlags: map[string]any{"new-checkout": checkout},
+			newFlags: map[string]any{
+				"checkout-v2": map[string]any{"flagType": "boolean", "defaultValue": true, "renamedFrom": "new-checkout"},
+			},
+			expected: []Change{{
+				Type: "rename", Path: "flags.checkout-v2", OldPath: "flags.new-checkout",
+				OldValue: checkout,
+				NewValue: map[string]any{"flagType": "boolean", "defaultValue": true, "renamedFrom": "new-checkout"},
+				Severity: SeverityBreaking,
+			}},
+		},
+		{
+			name:     "different default value",
+			oldFlags: map[string]any{"new-checkout": checkout},
+			newFlags: map[string]any{
+				"checkout-v2": map[string]any{"flagType": "boolean", "defaultValue": true, "description": "Enable the new checkout flow"},
+			},
+			expected: []Change{
+				{Type: "add", Path: "flags.checkout-v2", NewValue: map[string]any{"flagType": "boolean", "defaultValue": true, "description": "Enable the new checkout flow"}, Severity: SeveritySafe},
+				{Type: "remove", Path: "flags.new-checkout", OldValue: checkout, Severity: SeverityBreaking},
+			},
+		},
+		{
+			name:     "dissimilar description",
+			oldFlags: map[string]any{"new-checkout": checkout},
+			newFlags: map[string]any{
+				"dark-mode": map[string]any{"flagType": "boolean", "defaultValue": false, "description": "Use the dark color scheme"},
+			},
+			expected: []Change{
+				{Type: "add", Path: "flags.dark-mode", NewValue: map[string]any{"flagType": "boolean", "defaultValue": false, "description": "Use the dark color scheme"}, Severity: SeveritySafe},
+				{Type: "remove", Path: "flags.new-checkout", OldValue: checkout, Severity: SeverityBreaking},
+			},
+		},
+		{
+			name:     "flags without descriptions are not paired",
+			oldFlags: map[string]any{"a": map[string]any{"flagType": "boolean", "defaultValue": false}},
+			newFlags: map[string]any{"b": map[string]any{"flagType": "boolean", "defaultValue": false}},
+			expected: []Change{
+				{Type: "remove", Path: "flags.a", OldValue: map[string]any{"flagType": "boolean", "defaultValue": false}, Severity: SeverityBreaking},
+				{Type: "add", Path: "flags.b", NewValue: map[string]any{"flagType": "boolean", "defaultValue": false}, Severity: SeveritySafe},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes, err := Compare(&Manifest{Flags: tt.oldFlags}, &Manifest{Flags: tt.newFlags}, CompareOptions{DetectRenames: true})
+			require.NoError(t, err)
+			sortChanges(changes)
+			assert.Equal(t, tt.expected, changes)
+		})
+	}
+}
+
+func TestCompareDetectRenamesPairsMostSimilarFlags(t *testing.T) {
+	oldManifest := &Manifest{Flags: map[string]any{
+		"banner":  map[string]any{"flagType": "string", "defaultValue": "", "description": "Text of the promotion banner"},
+		"banner2": map[string]any{"flagType": "string", "defaultValue": "", "description": "Text of the promotion banners"},
+	}}
+	newManifest := &Manifest{Flags: map[string]any{
+		"promo-banner": map[string]any{"flagType": "string", "defaultValue": "", "description": "Text of the promotion banners"},
+	}}
+
+	changes, err := Compare(oldManifest, newManifest, CompareOptions{DetectRenames: true})
+	require.NoError(t, err)
+	sortChanges(changes)
+
+	require.Len(t, changes, 2)
+	assert.Equal(t, "remove", changes[0].Type)
+	assert.Equal(t, "flags.banner", changes[0].Path)
+	assert.Equal(t, "rename", changes[1].Type)
+	assert.Equal(t, "flags.banner2", changes[1].OldPath)
+}
+
+func TestCompareWithoutDetectRenames(t *testing.T) {
+	oldManifest := &Manifest{Flags: map[string]any{
+		"new-checkout": map[string]any{"flagType": "boolean", "defaultValue": false, "description": "Enable the new checkout flow"},
+	}}
+	newManifest := &Manifest{Flags: map[string]any{
+		"checkout-v2": map[string]any{"flagType": "boolean", "defaultValue": false, "description": "Enable the new checkout flow", "renamedFrom": "new-checkout"},
+	}}
+
+	changes, err := Compare(oldManifest, newManifest, CompareOptions{})
+	require.NoError(t, err)
+
+	types := []string{changes[0].Type, changes[1].Type}
+	sort.Strings(types)
+	assert.Equal(t, []string{"add", "remove"}, types)
+}
+
+func TestStringSimilarity(t *testing.T) {
+	assert.Equal(t, 1.0, stringSimilarity("Enable dark mode", " enable DARK mode "))
+	assert.Equal(t, 1.0, stringSimilarity("", ""))
+	assert.Equal(t, 0.0, stringSimilarity("abc", "xyz"))
+	assert.InDelta(t, 0.75, stringSimilarity("flag", "flax"), 0.001)
+}
@@ -0,0 +1,227 @@
+package manifest
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/open-feature/cli/internal/logger"
+)
+
+const (
+	defaultS3Region = "us-east-1"
+	s3Algorithm     = "AWS4-HMAC-SHA256"
+)
+
+// s3Backend stores a manifest as an object in an S3-compatible bucket.
+// Credentials, region and endpoint are read from the standard AWS environment variables;
+// setting AWS_ENDPOINT_URL_S3 or AWS_ENDPOINT_URL selects path-style requests to that
+// endpoint, e.g. a MinIO server.
+type s3Backend struct {
+	bucket string
+	key    string
+	region string
+	// endpoint is the base URL of an S3-compatible service. Empty for AWS.
+	endpoint     string
+	accessKey    string
+	secretKey    string
+	sessionToken string
+	httpClient   *http.Client
+	// now returns the signing time and defaults to time.Now
+	now func() time.Time
+}
+
+func newS3Backend(u *url.URL, opts BackendOptions) (*s3Backend, error) {
+	key := strings.TrimPrefix(u.Path, "/")
+	if u.Host == "" || key == "" {
+		return nil, fmt.Errorf("invalid S3 URL %q: expected s3://<bucket>/<key>", u.String())
+	}
+
+	httpClient, err := opts.httpClient(0)
+	if err != nil {
+		return nil, err
+	}
+
+	region := firstEnv("AWS_REGION", "AWS_DEFAULT_REGION")
+	if region == "" {
+		region = defaultS3Region
+	}
+
+	return &s3Backend{
+		bucket:       u.Host,
+		key:          key,
+		region:       region,
+		endpoint:     strings.TrimSuffix(firstEnv("AWS_ENDPOINT_URL_S3", "AWS_ENDPOINT_URL"), "/"),
+		accessKey:    os.Getenv("AWS_ACCESS_KEY_ID"),
+		secretKey:    os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		sessionToken: os.Getenv("AWS_SESSION_TOKEN"),
+		httpClient:   httpClient,
+		now:          time.Now,
+	}, nil
+}
+
+func (b *s3Backend) Pull(ctx context.Context) (*flagset.Flagset, error) {
+	resp, body, err := b.do(ctx, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get s3://%s/%s (status %d): %s", b.bucket, b.key, resp.StatusCode, s3ErrorMessage(body))
+	}
+
+	return loadFlagsFromData(body)
+}
+
+func (b *s3Backend) Push(ctx context.Context, flags *flagset.Flagset) error {
+	data, err := marshalManifest(flags)
+	if err != nil {
+		return fmt.Errorf("failed to encode manifest: %w", err)
+	}
+
+	resp, body, err := b.do(ctx, http.MethodPut, data)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to put s3://%s/%s (status %d): %s", b.bucket, b.key, resp.StatusCode, s3ErrorMessage(body))
+	}
+	return nil
+}
+
+// objectURL returns the URL of the object, using path-style addressing for custom endpoints
+func (b *s3Backend) objectURL() string {
+	key := escapeS3Key(b.key)
+	if b.endpoint != "" {
+		return fmt.Sprintf("%s/%s/%s", b.endpoint, b.bucket, key)
+	}
+	return fmt.Sprintf("https://%s.s3.%s.amazonaws.com/%s", b.bucket, b.region, key)
+}
+
+func (b *s3Backend) do(ctx context.Context, method string, payload []byte) (*http.Response, []byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, b.objectURL(), bytes.NewReader(payload))
+	if err != nil {
+		return nil, nil, err
+	}
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	b.sign(req, payload)
+
+	logger.Default.Debug(fmt.Sprintf("S3 %s %s", method, req.URL))
+	resp, err := b.httpClient.Do(req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to reach S3 endpoint: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp, body, nil
+}
+
+// sign adds an AWS Signature Version 4 to the request. Requests are sent anonymously without credentials.
+func (b *s3Backend) sign(req *http.Request, payload []byte) {
+	if b.accessKey == "" || b.secretKey == "" {
+		return
+	}
+
+	now := b.now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	payloadHash := sha256Hex(payload)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	if b.sessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", b.sessionToken)
+	}
+
+	signedHeaders := []string{"host", "x-amz-content-sha256", "x-amz-date"}
+	if b.sessionToken != "" {
+		signedHeaders = append(signedHeaders, "x-amz-security-token")
+	}
+	var canonicalHeaders strings.Builder
+	for _, name := range signedHeaders {
+		value := req.Header.Get(name)
+		if name == "host" {
+			value = req.URL.Host
+		}
+		canonicalHeaders.WriteString(name + ":" + strings.TrimSpace(value) + "\n")
+	}
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		strings.Join(signedHeaders, ";"),
+		payloadHash,
+	}, "\n")
+
+	scope := strings.Join([]string{date, b.region, "s3", "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{s3Algorithm, amzDate, scope, sha256Hex([]byte(canonicalRequest))}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+b.secretKey), date)
+	for _, part := range []string{b.region, "s3", "aws4_request"} {
+		signingKey = hmacSHA256(signingKey, part)
+	}
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3Algorithm, b.accessKey, scope, strings.Join(signedHeaders, ";"), signature))
+}
+
+// escapeS3Key escapes each segment of an object key, keeping the separators
+func escapeS3Key(key string) string {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+// s3ErrorMessage extracts the message of an S3 XML error response
+func s3ErrorMessage(body []byte) string {
+	var s3Error struct {
+		Code    string `xml:"Code"`
+		Message string `xml:"Message"`
+	}
+	if err := xml.Unmarshal(body, &s3Error); err != nil || s3Error.Message == "" {
+		return string(body)
+	}
+	return fmt.Sprintf("%s: %s", s3Error.Code, s3Error.Message)
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// firstEnv returns the value of the first environment variable that is set
+func firstEnv(names ...string) string {
+	for _, name := range names {
+		if value := os.Getenv(name); value != "" {
+			return value
+		}
+	}
+	return ""
+}
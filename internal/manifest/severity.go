@@ -0,0 +1,80 @@
+package manifest
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Severity classifies a change by its impact on the consumers of a manifest
+type Severity string
+
+const (
+	// SeveritySafe indicates a change that cannot break consumers, e.g. an added flag or a new description
+	SeveritySafe Severity = "safe"
+	// SeverityPotentiallyBreaking indicates a change that alters behavior without breaking generated
+	// code, e.g. a new default value
+	SeverityPotentiallyBreaking Severity = "potentially-breaking"
+	// SeverityBreaking indicates a change that breaks generated code in every consumer, e.g. a removed
+	// or renamed flag or a new flag type
+	SeverityBreaking Severity = "breaking"
+)
+
+// severityRank orders the severities from the least to the most severe
+var severityRank = map[Severity]int{
+	SeveritySafe:                0,
+	SeverityPotentiallyBreaking: 1,
+	SeverityBreaking:            2,
+}
+
+// ParseSeverity returns the severity with the given name
+func ParseSeverity(name string) (Severity, error) {
+	severity := Severity(strings.ToLower(name))
+	if _, ok := severityRank[severity]; !ok {
+		return "", fmt.Errorf("invalid severity: %s. Valid severities are: %s", name, strings.Join(GetValidSeverities(), ", "))
+	}
+	return severity, nil
+}
+
+// GetValidSeverities returns a list of all severities, from the most to the least severe
+func GetValidSeverities() []string {
+	return []string{
+		string(SeverityBreaking),
+		string(SeverityPotentiallyBreaking),
+		string(SeveritySafe),
+	}
+}
+
+// AtLeast reports whether the severity is at least as severe as the threshold
+func (s Severity) AtLeast(threshold Severity) bool {
+	return severityRank[s] >= severityRank[threshold]
+}
+
+// classifyChange returns the severity of a change:
+//   - removing or renaming a flag or changing its type is breaking
+//   - changing the default value of a flag is potentially breaking
+//   - anything else, such as adding a flag or changing its description, is safe
+func classifyChange(change Change) Severity {
+	switch change.Type {
+	case "add":
+		return SeveritySafe
+	case "remove", "rename":
+		// A renamed flag changes the names of the generated accessors
+		return SeverityBreaking
+	}
+
+	oldFlag, oldOk := change.OldValue.(map[string]any)
+	newFlag, newOk := change.NewValue.(map[string]any)
+	if !oldOk || !newOk {
+		// Without the fields of the flag, the change cannot be shown to be safe
+		return SeverityBreaking
+	}
+
+	if !reflect.DeepEqual(oldFlag["flagType"], newFlag["flagType"]) {
+		return SeverityBreaking
+	}
+	if !reflect.DeepEqual(oldFlag["defaultValue"], newFlag["defaultValue"]) {
+		return SeverityPotentiallyBreaking
+	}
+	return SeveritySafe
+}
@@ -0,0 +1,75 @@
+package manifest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareClassifiesChanges(t *testing.T) {
+	oldManifest := &Manifest{
+		Flags: map[string]any{
+			"removed":     map[string]any{"flagType": "boolean", "defaultValue": false},
+			"retyped":     map[string]any{"flagType": "integer", "defaultValue": 1},
+			"newDefault":  map[string]any{"flagType": "string", "defaultValue": "blue"},
+			"described":   map[string]any{"flagType": "string", "defaultValue": "red", "description": "Old"},
+			"unchanged":   map[string]any{"flagType": "boolean", "defaultValue": true},
+			"retypedOnly": map[string]any{"flagType": "integer", "defaultValue": 1, "description": "Old"},
+		},
+	}
+	newManifest := &Manifest{
+		Flags: map[string]any{
+			"added":       map[string]any{"flagType": "boolean", "defaultValue": false},
+			"retyped":     map[string]any{"flagType": "float", "defaultValue": 1},
+			"newDefault":  map[string]any{"flagType": "string", "defaultValue": "green"},
+			"described":   map[string]any{"flagType": "string", "defaultValue": "red", "description": "New"},
+			"unchanged":   map[string]any{"flagType": "boolean", "defaultValue": true},
+			"retypedOnly": map[string]any{"flagType": "float", "defaultValue": 2, "description": "New"},
+		},
+	}
+
+	changes, err := Compare(oldManifest, newManifest, CompareOptions{})
+	require.NoError(t, err)
+
+	severities := make(map[string]Severity, len(changes))
+	for _, change := range changes {
+		severities[change.Path] = change.Severity
+	}
+	assert.Equal(t, map[string]Severity{
+		"flags.added":       SeveritySafe,
+		"flags.removed":     SeverityBreaking,
+		"flags.retyped":     SeverityBreaking,
+		"flags.newDefault":  SeverityPotentiallyBreaking,
+		"flags.described":   SeveritySafe,
+		"flags.retypedOnly": SeverityBreaking,
+	}, severities)
+}
+
+func TestCompareIgnoredFieldsDoNotAffectSeverity(t *testing.T) {
+	oldManifest := &Manifest{Flags: map[string]any{
+		"flag": map[string]any{"flagType": "string", "defaultValue": "blue", "description": "Old"},
+	}}
+	newManifest := &Manifest{Flags: map[string]any{
+		"flag": map[string]any{"flagType": "string", "defaultValue": "green", "description": "New"},
+	}}
+
+	changes, err := Compare(oldManifest, newManifest, CompareOptions{IgnorePatterns: []string{"defaultValue"}})
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, SeveritySafe, changes[0].Severity)
+}
+
+func TestSeverity(t *testing.T) {
+	assert.True(t, SeverityBreaking.AtLeast(SeverityBreaking))
+	assert.True(t, SeverityBreaking.AtLeast(SeverityPotentiallyBreaking))
+	assert.False(t, SeverityPotentiallyBreaking.AtLeast(SeverityBreaking))
+	assert.True(t, SeveritySafe.AtLeast(SeveritySafe))
+
+	severity, err := ParseSeverity("Potentially-Breaking")
+	require.NoError(t, err)
+	assert.Equal(t, SeverityPotentiallyBreaking, severity)
+
+	_, err = ParseSeverity("major")
+	assert.EqualError(t, err, "invalid severity: major. Valid severities are: breaking, potentially-breaking, safe")
+}
@@ -0,0 +1,86 @@
+package manifest
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/open-feature/cli/internal/flagset"
+)
+
+// dateLayout is the layout used for the createdAt and expiresAt manifest fields
+const dateLayout = "2006-01-02"
+
+// StaleStatus describes why a flag is reported as stale
+type StaleStatus string
+
+const (
+	// StaleStatusExpired indicates that the flag's expiry date has passed
+	StaleStatusExpired StaleStatus = "expired"
+	// StaleStatusExpiring indicates that the flag expires within the reporting window
+	StaleStatusExpiring StaleStatus = "expiring"
+)
+
+// StaleFlag describes a flag that has expired or is about to expire
+type StaleFlag struct {
+	Key           string      `json:"key"`
+	Owner         string      `json:"owner,omitempty"`
+	Lifecycle     string      `json:"lifecycle,omitempty"`
+	ExpiresAt     string      `json:"expiresAt"`
+	DaysRemaining int         `json:"daysRemaining"`
+	Status        StaleStatus `json:"status"`
+}
+
+// IsExpiredTemporary reports whether the flag has expired and is not marked as permanent.
+// Flags with an expiry date but no explicit lifecycle are treated as temporary.
+func (s StaleFlag) IsExpiredTemporary() bool {
+	return s.Status == StaleStatusExpired && s.Lifecycle != string(flagset.LifecyclePermanent)
+}
+
+// FindStaleFlags returns the flags that expired before now or expire within the given number of days.
+// Flags without an expiry date are never reported. Results are sorted by owner and then by key.
+func FindStaleFlags(fs *flagset.Flagset, now time.Time, withinDays int) ([]StaleFlag, error) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	var stale []StaleFlag
+	for _, flag := range fs.Flags {
+		if flag.ExpiresAt == "" {
+			continue
+		}
+
+		expiresAt, err := time.Parse(dateLayout, flag.ExpiresAt)
+		if err != nil {
+			return nil, fmt.Errorf("invalid expiresAt %q for flag %s: expected YYYY-MM-DD", flag.ExpiresAt, flag.Key)
+		}
+
+		daysRemaining := int(expiresAt.Sub(today).Hours() / 24)
+
+		var status StaleStatus
+		switch {
+		case daysRemaining < 0:
+			status = StaleStatusExpired
+		case daysRemaining <= withinDays:
+			status = StaleStatusExpiring
+		default:
+			continue
+		}
+
+		stale = append(stale, StaleFlag{
+			Key:           flag.Key,
+			Owner:         flag.Owner,
+			Lifecycle:     string(flag.Lifecycle),
+			ExpiresAt:     flag.ExpiresAt,
+			DaysRemaining: daysRemaining,
+			Status:        status,
+		})
+	}
+
+	sort.Slice(stale, func(i, j int) bool {
+		if stale[i].Owner != stale[j].Owner {
+			return stale[i].Owner < stale[j].Owner
+		}
+		return stale[i].Key < stale[j].Key
+	})
+
+	return stale, nil
+}
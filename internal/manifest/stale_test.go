@@ -0,0 +1,83 @@
+package manifest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindStaleFlags(t *testing.T) {
+	now := time.Date(2025, time.March, 10, 15, 30, 0, 0, time.UTC)
+
+	fs := &flagset.Flagset{
+		Flags: []flagset.Flag{
+			{Key: "no-expiry", Type: flagset.BoolType},
+			{Key: "long-gone", Type: flagset.BoolType, Owner: "team-b", ExpiresAt: "2025-01-01", Lifecycle: flagset.LifecycleTemporary},
+			{Key: "kept-forever", Type: flagset.BoolType, Owner: "team-a", ExpiresAt: "2025-03-01", Lifecycle: flagset.LifecyclePermanent},
+			{Key: "expires-today", Type: flagset.BoolType, Owner: "team-a", ExpiresAt: "2025-03-10"},
+			{Key: "expires-soon", Type: flagset.BoolType, Owner: "team-b", ExpiresAt: "2025-03-20"},
+			{Key: "far-future", Type: flagset.BoolType, Owner: "team-a", ExpiresAt: "2026-01-01"},
+		},
+	}
+
+	stale, err := FindStaleFlags(fs, now, 14)
+	require.NoError(t, err)
+
+	expected := []StaleFlag{
+		{Key: "expires-today", Owner: "team-a", ExpiresAt: "2025-03-10", DaysRemaining: 0, Status: StaleStatusExpiring},
+		{Key: "kept-forever", Owner: "team-a", Lifecycle: "permanent", ExpiresAt: "2025-03-01", DaysRemaining: -9, Status: StaleStatusExpired},
+		{Key: "expires-soon", Owner: "team-b", ExpiresAt: "2025-03-20", DaysRemaining: 10, Status: StaleStatusExpiring},
+		{Key: "long-gone", Owner: "team-b", Lifecycle: "temporary", ExpiresAt: "2025-01-01", DaysRemaining: -68, Status: StaleStatusExpired},
+	}
+	assert.Equal(t, expected, stale)
+}
+
+func TestFindStaleFlagsInvalidDate(t *testing.T) {
+	fs := &flagset.Flagset{
+		Flags: []flagset.Flag{
+			{Key: "bad-date", Type: flagset.BoolType, ExpiresAt: "next week"},
+		},
+	}
+
+	_, err := FindStaleFlags(fs, time.Now(), 14)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "bad-date")
+}
+
+func TestStaleFlagIsExpiredTemporary(t *testing.T) {
+	tests := []struct {
+		name     string
+		flag     StaleFlag
+		expected bool
+	}{
+		{
+			name:     "expired temporary flag",
+			flag:     StaleFlag{Status: StaleStatusExpired, Lifecycle: "temporary"},
+			expected: true,
+		},
+		{
+			name:     "expired flag without lifecycle",
+			flag:     StaleFlag{Status: StaleStatusExpired},
+			expected: true,
+		},
+		{
+			name:     "expired permanent flag",
+			flag:     StaleFlag{Status: StaleStatusExpired, Lifecycle: "permanent"},
+			expected: false,
+		},
+		{
+			name:     "expiring temporary flag",
+			flag:     StaleFlag{Status: StaleStatusExpiring, Lifecycle: "temporary"},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.flag.IsExpiredTemporary())
+		})
+	}
+}
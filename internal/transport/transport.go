@@ -0,0 +1,170 @@
+// Package transport builds the HTTP clients used for all requests to remote flag sources,
+// applying custom certificate authorities, client certificates and proxies
+package transport
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/logger"
+	"github.com/spf13/afero"
+)
+
+// Options configures how connections to a remote location are made
+type Options struct {
+	// CACert is the path of a PEM file with certificate authorities to trust in addition to the system ones
+	CACert string
+	// ClientCert is the path of a PEM client certificate for mutual TLS, used together with ClientKey
+	ClientCert string
+	// ClientKey is the path of the PEM private key of ClientCert
+	ClientKey string
+	// Proxy is the URL of the proxy for all requests. Without it, HTTP_PROXY, HTTPS_PROXY and NO_PROXY are used.
+	Proxy string
+}
+
+// IsZero reports whether no options are set
+func (o Options) IsZero() bool {
+	return o == Options{}
+}
+
+// Merge returns the options with every unset field taken from fallback
+func (o Options) Merge(fallback Options) Options {
+	if o.CACert == "" {
+		o.CACert = fallback.CACert
+	}
+	if o.ClientCert == "" {
+		o.ClientCert = fallback.ClientCert
+	}
+	if o.ClientKey == "" {
+		o.ClientKey = fallback.ClientKey
+	}
+	if o.Proxy == "" {
+		o.Proxy = fallback.Proxy
+	}
+	return o
+}
+
+// New returns a transport applying the options. Without options, http.DefaultTransport is returned.
+func New(opts Options) (http.RoundTripper, error) {
+	if opts.IsZero() {
+		return http.DefaultTransport, nil
+	}
+
+	transport := baseTransport()
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if opts.CACert != "" {
+		pool, err := certPool(opts.CACert)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if opts.ClientCert != "" || opts.ClientKey != "" {
+		if opts.ClientCert == "" || opts.ClientKey == "" {
+			return nil, fmt.Errorf("a client certificate and a client key are both required for mutual TLS")
+		}
+		cert, err := clientCertificate(opts.ClientCert, opts.ClientKey)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	if opts.Proxy != "" {
+		proxyURL, err := url.Parse(opts.Proxy)
+		if err != nil || proxyURL.Scheme == "" || proxyURL.Host == "" {
+			return nil, fmt.Errorf("invalid proxy URL %q", opts.Proxy)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	logger.Default.Debug(fmt.Sprintf("Using transport with CA certificate %q, client certificate %q and proxy %q", opts.CACert, opts.ClientCert, redactProxy(opts.Proxy)))
+	return transport, nil
+}
+
+// NewClient returns an HTTP client using the transport for the options. Without options, the client
+// uses http.DefaultTransport. A zero timeout means no timeout.
+func NewClient(opts Options, timeout time.Duration) (*http.Client, error) {
+	client := &http.Client{Timeout: timeout}
+	if opts.IsZero() {
+		// Leaving the transport unset resolves http.DefaultTransport on every request
+		return client, nil
+	}
+
+	transport, err := New(opts)
+	if err != nil {
+		return nil, err
+	}
+	client.Transport = transport
+	return client, nil
+}
+
+// baseTransport returns a copy of the default transport to apply the options to
+func baseTransport() *http.Transport {
+	if transport, ok := http.DefaultTransport.(*http.Transport); ok {
+		return transport.Clone()
+	}
+	// http.DefaultTransport may have been replaced, e.g. in tests
+	return &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
+}
+
+// certPool returns the system certificate pool with the certificates of the PEM file added
+func certPool(path string) (*x509.CertPool, error) {
+	data, err := afero.ReadFile(filesystem.FileSystem(), path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA certificate: %w", err)
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		logger.Default.Debug(fmt.Sprintf("System certificate pool unavailable, trusting only %s: %v", path, err))
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("no certificates found in CA certificate file %s", path)
+	}
+	return pool, nil
+}
+
+// clientCertificate loads a PEM certificate and private key
+func clientCertificate(certPath, keyPath string) (tls.Certificate, error) {
+	fs := filesystem.FileSystem()
+	certPEM, err := afero.ReadFile(fs, certPath)
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("failed to read client certificate: %w", err)
+	}
+	keyPEM, err := afero.ReadFile(fs, keyPath)
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("failed to read client key: %w", err)
+	}
+
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("invalid client certificate or key: %w", err)
+	}
+	return cert, nil
+}
+
+// redactProxy removes credentials from a proxy URL for logging
+func redactProxy(proxy string) string {
+	u, err := url.Parse(proxy)
+	if err != nil || u.User == nil {
+		return proxy
+	}
+	return u.Redacted()
+}
@@ -0,0 +1,154 @@
+package transport
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writePEM writes a PEM block to a file in dir and returns its path
+func writePEM(t *testing.T, dir, name, blockType string, der []byte) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600))
+	return path
+}
+
+// newClientCertificate creates a self-signed client certificate and returns it with the paths of its PEM files
+func newClientCertificate(t *testing.T, dir string) (*x509.Certificate, string, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "openfeature-cli"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return cert, writePEM(t, dir, "client.pem", "CERTIFICATE", der), writePEM(t, dir, "client-key.pem", "EC PRIVATE KEY", keyDER)
+}
+
+func TestNewWithoutOptionsUsesDefaultTransport(t *testing.T) {
+	rt, err := New(Options{})
+	require.NoError(t, err)
+	assert.Same(t, http.DefaultTransport, rt)
+
+	client, err := NewClient(Options{}, time.Second)
+	require.NoError(t, err)
+	assert.Nil(t, client.Transport)
+	assert.Equal(t, time.Second, client.Timeout)
+}
+
+func TestNewClientMutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	clientCert, certPath, keyPath := newClientCertificate(t, dir)
+
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(clientCert)
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.TLS.PeerCertificates[0].Subject.CommonName))
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+	caPath := writePEM(t, dir, "ca.pem", "CERTIFICATE", server.Certificate().Raw)
+
+	t.Run("server certificate signed by an unknown CA is rejected", func(t *testing.T) {
+		client, err := NewClient(Options{}, time.Second)
+		require.NoError(t, err)
+		_, err = client.Get(server.URL)
+		assert.ErrorContains(t, err, "certificate")
+	})
+
+	t.Run("server rejects requests without a client certificate", func(t *testing.T) {
+		client, err := NewClient(Options{CACert: caPath}, time.Second)
+		require.NoError(t, err)
+		_, err = client.Get(server.URL)
+		assert.Error(t, err)
+	})
+
+	t.Run("client certificate is presented", func(t *testing.T) {
+		client, err := NewClient(Options{CACert: caPath, ClientCert: certPath, ClientKey: keyPath}, time.Second)
+		require.NoError(t, err)
+		resp, err := client.Get(server.URL)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+}
+
+func TestNewClientProxy(t *testing.T) {
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.String()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(proxy.Close)
+
+	client, err := NewClient(Options{Proxy: proxy.URL}, time.Second)
+	require.NoError(t, err)
+	resp, err := client.Get("http://flags.internal.example.com/flags.json")
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Equal(t, "http://flags.internal.example.com/flags.json", proxied)
+}
+
+func TestNewInvalidOptions(t *testing.T) {
+	dir := t.TempDir()
+	_, certPath, keyPath := newClientCertificate(t, dir)
+	emptyPath := filepath.Join(dir, "empty.pem")
+	require.NoError(t, os.WriteFile(emptyPath, nil, 0o600))
+
+	tests := []struct {
+		name          string
+		opts          Options
+		expectedError string
+	}{
+		{"missing CA file", Options{CACert: filepath.Join(dir, "missing.pem")}, "failed to read CA certificate"},
+		{"CA file without certificates", Options{CACert: emptyPath}, "no certificates found in CA certificate file"},
+		{"client certificate without key", Options{ClientCert: certPath}, "a client certificate and a client key are both required"},
+		{"mismatched client key", Options{ClientCert: certPath, ClientKey: certPath}, "invalid client certificate or key"},
+		{"client key without certificate", Options{ClientKey: keyPath}, "a client certificate and a client key are both required"},
+		{"invalid proxy", Options{Proxy: "proxy.internal:3128"}, "invalid proxy URL"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(tt.opts)
+			assert.ErrorContains(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestOptionsMerge(t *testing.T) {
+	opts := Options{CACert: "flag-ca.pem"}.Merge(Options{CACert: "host-ca.pem", Proxy: "http://proxy.internal:3128"})
+	assert.Equal(t, Options{CACert: "flag-ca.pem", Proxy: "http://proxy.internal:3128"}, opts)
+}